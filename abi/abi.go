@@ -0,0 +1,113 @@
+// Package abi provides parsing of MultiversX smart contract ABI definitions and
+// ABI-driven encoding/decoding of smart contract call arguments and results.
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Input describes a single endpoint input parameter as found in the ABI
+type Input struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Output describes a single endpoint output value as found in the ABI
+type Output struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type"`
+}
+
+// Endpoint describes a single callable/queryable smart contract endpoint
+type Endpoint struct {
+	Name       string   `json:"name"`
+	Mutability string   `json:"mutability,omitempty"`
+	Inputs     []Input  `json:"inputs"`
+	Outputs    []Output `json:"outputs"`
+}
+
+// EventInput describes a single indexed or non-indexed event parameter
+type EventInput struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed"`
+}
+
+// Event describes a log event emitted by the smart contract
+type Event struct {
+	Identifier string       `json:"identifier"`
+	Inputs     []EventInput `json:"inputs"`
+}
+
+// StructField describes a single field of a user-defined struct type
+type StructField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// EnumVariant describes a single variant of a user-defined enum type
+type EnumVariant struct {
+	Name         string        `json:"name"`
+	Discriminant int           `json:"discriminant"`
+	Fields       []StructField `json:"fields,omitempty"`
+}
+
+// TypeDefinition describes a user-defined type (struct or enum) as found in the ABI "types" section
+type TypeDefinition struct {
+	Type     string        `json:"type"` // "struct" or "enum"
+	Fields   []StructField `json:"fields,omitempty"`
+	Variants []EnumVariant `json:"variants,omitempty"`
+}
+
+// ABI holds the parsed contents of a MultiversX contract ABI json file
+type ABI struct {
+	Name      string                    `json:"name"`
+	Endpoints []Endpoint                `json:"endpoints"`
+	Events    []Event                   `json:"events"`
+	Types     map[string]TypeDefinition `json:"types"`
+
+	endpointsByName map[string]Endpoint
+	eventsByID      map[string]Event
+}
+
+// NewABIFromJSON parses the provided MultiversX contract ABI json payload
+func NewABIFromJSON(data []byte) (*ABI, error) {
+	abi := &ABI{}
+	err := json.Unmarshal(data, abi)
+	if err != nil {
+		return nil, fmt.Errorf("%w while unmarshalling abi json", err)
+	}
+
+	abi.endpointsByName = make(map[string]Endpoint, len(abi.Endpoints))
+	for _, endpoint := range abi.Endpoints {
+		abi.endpointsByName[endpoint.Name] = endpoint
+	}
+
+	abi.eventsByID = make(map[string]Event, len(abi.Events))
+	for _, event := range abi.Events {
+		abi.eventsByID[event.Identifier] = event
+	}
+
+	return abi, nil
+}
+
+// Endpoint returns the endpoint definition having the provided name
+func (a *ABI) Endpoint(name string) (Endpoint, error) {
+	endpoint, found := a.endpointsByName[name]
+	if !found {
+		return Endpoint{}, fmt.Errorf("%w: %s", ErrEndpointNotFound, name)
+	}
+
+	return endpoint, nil
+}
+
+// Event returns the event definition having the provided identifier
+func (a *ABI) Event(identifier string) (Event, error) {
+	event, found := a.eventsByID[identifier]
+	if !found {
+		return Event{}, fmt.Errorf("%w: %s", ErrEventNotFound, identifier)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,190 @@
+package abi
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testABI() *ABI {
+	return &ABI{
+		Types: map[string]TypeDefinition{
+			"Reward": {
+				Type: "struct",
+				Fields: []StructField{
+					{Name: "Token", Type: "TokenIdentifier"},
+					{Name: "Amount", Type: "BigUint"},
+				},
+			},
+			"Status": {
+				Type: "enum",
+				Variants: []EnumVariant{
+					{Name: "Active", Discriminant: 0},
+					{Name: "Paused", Discriminant: 1},
+				},
+			},
+		},
+	}
+}
+
+func TestParseType(t *testing.T) {
+	t.Parallel()
+
+	a := testABI()
+
+	typ, err := ParseType(a, "List<Option<BigUint>>")
+	require.Nil(t, err)
+	require.Equal(t, KindList, typ.Kind)
+	require.Equal(t, KindOption, typ.Elem.Kind)
+	require.Equal(t, KindBigUint, typ.Elem.Elem.Kind)
+
+	_, err = ParseType(a, "NotAType")
+	require.ErrorIs(t, err, ErrUnknownType)
+}
+
+func TestEncodeDecode_Scalars(t *testing.T) {
+	t.Parallel()
+
+	a := testABI()
+
+	u64Type, _ := ParseType(a, "u64")
+	encoded, err := EncodeTopLevel(u64Type, uint64(1024))
+	require.Nil(t, err)
+	decoded, err := DecodeTopLevel(u64Type, encoded)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1024), decoded)
+
+	bigUintType, _ := ParseType(a, "BigUint")
+	encoded, err = EncodeTopLevel(bigUintType, big.NewInt(123456789))
+	require.Nil(t, err)
+	decoded, err = DecodeTopLevel(bigUintType, encoded)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(123456789), decoded)
+
+	i32Type, _ := ParseType(a, "i32")
+	encoded, err = EncodeTopLevel(i32Type, int32(-1))
+	require.Nil(t, err)
+	require.Equal(t, []byte{0xff, 0xff, 0xff, 0xff}, encoded)
+	decoded, err = DecodeTopLevel(i32Type, encoded)
+	require.Nil(t, err)
+	require.Equal(t, int64(-1), decoded)
+
+	_, err = EncodeTopLevel(i32Type, int64(1)<<40)
+	require.ErrorIs(t, err, ErrInvalidEncodedValue)
+}
+
+func TestDecodeTopLevel_RejectsOversizedFixedWidthScalar(t *testing.T) {
+	t.Parallel()
+
+	a := testABI()
+
+	u64Type, _ := ParseType(a, "u64")
+	_, err := DecodeTopLevel(u64Type, make([]byte, 32))
+	require.ErrorIs(t, err, ErrInvalidEncodedValue)
+
+	i8Type, _ := ParseType(a, "i8")
+	_, err = DecodeTopLevel(i8Type, make([]byte, 10))
+	require.ErrorIs(t, err, ErrInvalidEncodedValue)
+}
+
+func TestEncodeDecode_BigInt(t *testing.T) {
+	t.Parallel()
+
+	a := testABI()
+	bigIntType, _ := ParseType(a, "BigInt")
+
+	encoded, err := EncodeTopLevel(bigIntType, big.NewInt(-5))
+	require.Nil(t, err)
+	require.Equal(t, []byte{0xfb}, encoded)
+	decoded, err := DecodeTopLevel(bigIntType, encoded)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(-5), decoded)
+
+	encoded, err = EncodeTopLevel(bigIntType, big.NewInt(5))
+	require.Nil(t, err)
+	require.Equal(t, []byte{0x05}, encoded)
+	require.NotEqual(t, encoded, func() []byte {
+		enc, _ := EncodeTopLevel(bigIntType, big.NewInt(-5))
+		return enc
+	}())
+
+	encoded, err = EncodeTopLevel(bigIntType, big.NewInt(-129))
+	require.Nil(t, err)
+	decoded, err = DecodeTopLevel(bigIntType, encoded)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(-129), decoded)
+
+	bigUintType, _ := ParseType(a, "BigUint")
+	_, err = EncodeTopLevel(bigUintType, big.NewInt(-1))
+	require.ErrorIs(t, err, ErrInvalidEncodedValue)
+}
+
+func TestEncodeDecode_Enum(t *testing.T) {
+	t.Parallel()
+
+	a := testABI()
+	statusType, err := ParseType(a, "Status")
+	require.Nil(t, err)
+
+	encoded, err := EncodeTopLevel(statusType, "Paused")
+	require.Nil(t, err)
+	require.Equal(t, []byte{0x01}, encoded)
+
+	decoded, err := DecodeTopLevel(statusType, encoded)
+	require.Nil(t, err)
+	require.Equal(t, "Paused", decoded)
+}
+
+func TestEncodeDecode_Option(t *testing.T) {
+	t.Parallel()
+
+	a := testABI()
+	optType, _ := ParseType(a, "Option<u32>")
+
+	encoded, err := EncodeTopLevel(optType, nil)
+	require.Nil(t, err)
+	require.Equal(t, []byte{}, encoded)
+
+	encoded, err = EncodeTopLevel(optType, uint32(7))
+	require.Nil(t, err)
+	decoded, err := DecodeTopLevel(optType, encoded)
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), decoded)
+}
+
+func TestEncodeDecode_List(t *testing.T) {
+	t.Parallel()
+
+	a := testABI()
+	listType, _ := ParseType(a, "List<u32>")
+
+	encoded, err := EncodeTopLevel(listType, []interface{}{uint32(1), uint32(2), uint32(3)})
+	require.Nil(t, err)
+
+	decoded, err := DecodeTopLevel(listType, encoded)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{uint64(1), uint64(2), uint64(3)}, decoded)
+}
+
+func TestEncodeDecode_Struct(t *testing.T) {
+	t.Parallel()
+
+	a := testABI()
+	structType, err := ParseType(a, "Reward")
+	require.Nil(t, err)
+
+	value := map[string]interface{}{
+		"Token":  "WEGLD-abcdef",
+		"Amount": big.NewInt(500),
+	}
+	encoded, err := EncodeTopLevel(structType, value)
+	require.Nil(t, err)
+
+	decoded, err := DecodeTopLevel(structType, encoded)
+	require.Nil(t, err)
+	decodedMap, ok := decoded.(map[string]interface{})
+	require.True(t, ok)
+	require.Equal(t, "WEGLD-abcdef", decodedMap["Token"])
+	require.Equal(t, big.NewInt(500), decodedMap["Amount"])
+}
@@ -0,0 +1,335 @@
+package abi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+// DecodeTopLevel decodes a single top-level value (one return data chunk) into a Go value
+// matching typ. Top-level values carry no internal length prefix since the chunk itself is
+// the full encoded value.
+func DecodeTopLevel(typ Type, data []byte) (interface{}, error) {
+	switch typ.Kind {
+	case KindOption:
+		if len(data) == 0 {
+			return nil, nil
+		}
+		if data[0] != 0x01 {
+			return nil, fmt.Errorf("%w: unexpected Option discriminant byte", ErrInvalidEncodedValue)
+		}
+		value, _, err := decodeNested(*typ.Elem, data[1:])
+		return value, err
+	case KindList:
+		return decodeListFromRaw(typ, data)
+	case KindTuple:
+		return decodeTupleFromRaw(typ, data)
+	case KindStruct:
+		return decodeStructFromRaw(typ, data)
+	case KindEnum:
+		return decodeEnumFromRaw(typ, data)
+	default:
+		width := fixedWidth(typ.Kind)
+		if typ.Kind == KindBool {
+			width = 1
+		}
+		if !typ.IsDynamicLength() && len(data) > width {
+			return nil, fmt.Errorf("%w: too many bytes for %v", ErrInvalidEncodedValue, typ.Kind)
+		}
+		return decodeScalar(typ, data)
+	}
+}
+
+// decodeNested decodes a single value occurring inside a List, tuple or struct, returning the
+// decoded value together with the remaining unconsumed bytes.
+func decodeNested(typ Type, data []byte) (interface{}, []byte, error) {
+	switch typ.Kind {
+	case KindOption:
+		if len(data) == 0 {
+			return nil, nil, fmt.Errorf("%w: truncated Option", ErrInvalidEncodedValue)
+		}
+		if data[0] == 0x00 {
+			return nil, data[1:], nil
+		}
+		return decodeNested(*typ.Elem, data[1:])
+	case KindList, KindBigUint, KindBigInt, KindTokenIdentifier, KindBytes, KindStruct:
+		length, rest, err := readLengthPrefix(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(rest) < int(length) {
+			return nil, nil, fmt.Errorf("%w: declared length exceeds available data", ErrInvalidEncodedValue)
+		}
+		chunk, remaining := rest[:length], rest[length:]
+
+		var value interface{}
+		switch typ.Kind {
+		case KindList:
+			value, err = decodeListFromRaw(typ, chunk)
+		case KindStruct:
+			value, err = decodeStructFromRaw(typ, chunk)
+		default:
+			value, err = decodeScalar(typ, chunk)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return value, remaining, nil
+	case KindTuple:
+		value, remaining, err := decodeTupleFixedPrefix(typ, data)
+		return value, remaining, err
+	case KindEnum:
+		if !typ.IsDynamicLength() {
+			if len(data) < 1 {
+				return nil, nil, fmt.Errorf("%w: truncated enum", ErrInvalidEncodedValue)
+			}
+			value, err := decodeEnumFromRaw(typ, data[:1])
+			return value, data[1:], err
+		}
+
+		length, rest, err := readLengthPrefix(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(rest) < int(length) {
+			return nil, nil, fmt.Errorf("%w: declared length exceeds available data", ErrInvalidEncodedValue)
+		}
+		chunk, remaining := rest[:length], rest[length:]
+
+		value, err := decodeEnumFromRaw(typ, chunk)
+		if err != nil {
+			return nil, nil, err
+		}
+		return value, remaining, nil
+	default:
+		width := fixedWidth(typ.Kind)
+		if typ.Kind == KindBool {
+			width = 1
+		}
+		if len(data) < width {
+			return nil, nil, fmt.Errorf("%w: not enough bytes for %v", ErrInvalidEncodedValue, typ.Kind)
+		}
+		value, err := decodeScalar(typ, data[:width])
+		if err != nil {
+			return nil, nil, err
+		}
+		return value, data[width:], nil
+	}
+}
+
+func readLengthPrefix(data []byte) (uint32, []byte, error) {
+	if len(data) < 4 {
+		return 0, nil, fmt.Errorf("%w: missing length prefix", ErrInvalidEncodedValue)
+	}
+	return binary.BigEndian.Uint32(data[:4]), data[4:], nil
+}
+
+func decodeListFromRaw(typ Type, data []byte) ([]interface{}, error) {
+	items := make([]interface{}, 0)
+	for len(data) > 0 {
+		value, remaining, err := decodeNested(*typ.Elem, data)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, value)
+		data = remaining
+	}
+
+	return items, nil
+}
+
+func decodeTupleFromRaw(typ Type, data []byte) ([]interface{}, error) {
+	items, _, err := decodeTupleFixedPrefix(typ, data)
+	return items, err
+}
+
+func decodeTupleFixedPrefix(typ Type, data []byte) ([]interface{}, []byte, error) {
+	items := make([]interface{}, 0, len(typ.TupleElems))
+	for _, elemType := range typ.TupleElems {
+		value, remaining, err := decodeNested(elemType, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, value)
+		data = remaining
+	}
+
+	return items, data, nil
+}
+
+func decodeStructFromRaw(typ Type, data []byte) (map[string]interface{}, error) {
+	fields := make(map[string]interface{}, len(typ.StructDef.Fields))
+	for _, field := range typ.StructDef.Fields {
+		fieldType, err := ParseType(typ.abi, field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		value, remaining, err := decodeNested(fieldType, data)
+		if err != nil {
+			return nil, err
+		}
+		fields[field.Name] = value
+		data = remaining
+	}
+
+	return fields, nil
+}
+
+// decodeEnumFromRaw decodes a discriminant byte followed by the variant's fields (if any) into
+// either the bare variant name (no-field variants) or map[string]interface{}{"variant": name,
+// "fields": map[string]interface{}} (variants carrying fields)
+func decodeEnumFromRaw(typ Type, data []byte) (interface{}, error) {
+	if len(data) < 1 {
+		return nil, fmt.Errorf("%w: truncated enum", ErrInvalidEncodedValue)
+	}
+
+	variant, found := findEnumVariantByDiscriminant(typ.EnumDef, int(data[0]))
+	if !found {
+		return nil, fmt.Errorf("%w: enum %s has no variant with discriminant %d", ErrInvalidEncodedValue, typ.Name, data[0])
+	}
+	if len(variant.Fields) == 0 {
+		return variant.Name, nil
+	}
+
+	fields := make(map[string]interface{}, len(variant.Fields))
+	rest := data[1:]
+	for _, field := range variant.Fields {
+		fieldType, err := ParseType(typ.abi, field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		value, remaining, err := decodeNested(fieldType, rest)
+		if err != nil {
+			return nil, err
+		}
+		fields[field.Name] = value
+		rest = remaining
+	}
+
+	return map[string]interface{}{"variant": variant.Name, "fields": fields}, nil
+}
+
+func decodeScalar(typ Type, data []byte) (interface{}, error) {
+	switch typ.Kind {
+	case KindBool:
+		if len(data) == 0 {
+			return false, nil
+		}
+		return data[0] != 0x00, nil
+	case KindU8, KindU16, KindU32, KindU64:
+		return padToUint64(data), nil
+	case KindI8, KindI16, KindI32, KindI64:
+		return int64(signExtend(padToUint64(data), len(data))), nil
+	case KindBigUint:
+		return new(big.Int).SetBytes(data), nil
+	case KindBigInt:
+		return decodeSignedBigInt(data), nil
+	case KindAddress:
+		return append([]byte{}, data...), nil
+	case KindTokenIdentifier:
+		return string(data), nil
+	case KindBytes:
+		return append([]byte{}, data...), nil
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnknownType, typ.Kind)
+	}
+}
+
+func padToUint64(data []byte) uint64 {
+	buf := make([]byte, 8)
+	copy(buf[8-len(data):], data)
+	return binary.BigEndian.Uint64(buf)
+}
+
+// decodeSignedBigInt interprets data as a minimal big-endian two's complement representation,
+// the counterpart of encodeSignedBigInt: an empty chunk is zero, a high sign bit means the
+// value is negative, and the magnitude is recovered by subtracting 2^(8*len(data)).
+func decodeSignedBigInt(data []byte) *big.Int {
+	if len(data) == 0 {
+		return big.NewInt(0)
+	}
+	v := new(big.Int).SetBytes(data)
+	if data[0]&0x80 == 0 {
+		return v
+	}
+	mod := new(big.Int).Lsh(big.NewInt(1), uint(len(data)*8))
+	return v.Sub(v, mod)
+}
+
+// signExtend interprets v (zero-padded from a two's-complement encoding of the given byte width)
+// as a signed value, propagating the sign bit into the unused upper bits
+func signExtend(v uint64, width int) uint64 {
+	if width <= 0 || width >= 8 {
+		return v
+	}
+
+	signBit := uint64(1) << (uint(width)*8 - 1)
+	if v&signBit != 0 {
+		v |= ^uint64(0) << (uint(width) * 8)
+	}
+
+	return v
+}
+
+// Decode interprets the return data chunks produced by a VM query or an ApiSmartContractResult
+// according to the endpoint's declared outputs and writes the decoded values into out, which
+// must be pointers (e.g. *uint64, *string, *[]byte for Address/bytes values, **big.Int).
+func Decode(contractABI *ABI, endpointName string, vmOutputReturnData [][]byte, out ...interface{}) error {
+	if contractABI == nil {
+		return ErrNilABI
+	}
+
+	endpoint, err := contractABI.Endpoint(endpointName)
+	if err != nil {
+		return err
+	}
+	if len(endpoint.Outputs) != len(out) || len(endpoint.Outputs) != len(vmOutputReturnData) {
+		return fmt.Errorf("%w: endpoint %s declares %d outputs, got %d destinations and %d return data chunks",
+			ErrArgsCountMismatch, endpointName, len(endpoint.Outputs), len(out), len(vmOutputReturnData))
+	}
+
+	for i, output := range endpoint.Outputs {
+		outType, err := ParseType(contractABI, output.Type)
+		if err != nil {
+			return err
+		}
+
+		value, err := DecodeTopLevel(outType, vmOutputReturnData[i])
+		if err != nil {
+			return err
+		}
+
+		if err = assignOut(out[i], value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func assignOut(destination interface{}, value interface{}) error {
+	rv := reflect.ValueOf(destination)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("%w: destination must be a non-nil pointer", ErrUnsupportedOutputDestination)
+	}
+	if value == nil {
+		return nil
+	}
+
+	target := rv.Elem()
+	source := reflect.ValueOf(value)
+
+	if source.Type().AssignableTo(target.Type()) {
+		target.Set(source)
+		return nil
+	}
+	if source.Type().ConvertibleTo(target.Type()) {
+		target.Set(source.Convert(target.Type()))
+		return nil
+	}
+
+	return fmt.Errorf("%w: cannot assign %s into %s", ErrUnsupportedOutputDestination, source.Type(), target.Type())
+}
@@ -0,0 +1,429 @@
+package abi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+// addressHandler mirrors core.AddressHandler's relevant method so this package does not
+// need to import a concrete implementation in order to accept any address value
+type addressHandler interface {
+	AddressBytes() []byte
+}
+
+// EncodeTopLevel encodes a single Go value as the top-level (argument) representation of typ.
+// Top-level encoding omits the length prefix for dynamic-length types, since the argument
+// boundary (the "@" separator) already delimits the value.
+func EncodeTopLevel(typ Type, value interface{}) ([]byte, error) {
+	switch typ.Kind {
+	case KindOption:
+		if value == nil {
+			return []byte{}, nil
+		}
+		inner, err := EncodeNested(*typ.Elem, value)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{0x01}, inner...), nil
+	case KindList:
+		return encodeListElements(typ, value)
+	case KindTuple:
+		return encodeTupleElements(typ, value)
+	case KindStruct:
+		return encodeStructFields(typ, value)
+	case KindEnum:
+		return encodeEnumValue(typ, value)
+	default:
+		return encodeScalar(typ, value)
+	}
+}
+
+// EncodeNested encodes a single Go value as it would appear nested inside a List, tuple or
+// struct: dynamic-length types are prefixed with their length as a big-endian uint32.
+func EncodeNested(typ Type, value interface{}) ([]byte, error) {
+	switch typ.Kind {
+	case KindOption:
+		if value == nil {
+			return []byte{0x00}, nil
+		}
+		inner, err := EncodeNested(*typ.Elem, value)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{0x01}, inner...), nil
+	case KindList:
+		elems, err := encodeListElements(typ, value)
+		if err != nil {
+			return nil, err
+		}
+		return withLengthPrefix(elems), nil
+	case KindTuple:
+		return encodeTupleElements(typ, value)
+	case KindStruct:
+		fields, err := encodeStructFields(typ, value)
+		if err != nil {
+			return nil, err
+		}
+		return withLengthPrefix(fields), nil
+	case KindEnum:
+		raw, err := encodeEnumValue(typ, value)
+		if err != nil {
+			return nil, err
+		}
+		if typ.IsDynamicLength() {
+			return withLengthPrefix(raw), nil
+		}
+		return raw, nil
+	case KindBigUint, KindBigInt, KindTokenIdentifier, KindBytes:
+		raw, err := encodeScalar(typ, value)
+		if err != nil {
+			return nil, err
+		}
+		return withLengthPrefix(raw), nil
+	default:
+		return encodeScalar(typ, value)
+	}
+}
+
+func withLengthPrefix(data []byte) []byte {
+	prefix := make([]byte, 4)
+	binary.BigEndian.PutUint32(prefix, uint32(len(data)))
+	return append(prefix, data...)
+}
+
+func encodeListElements(typ Type, value interface{}) ([]byte, error) {
+	items, err := toInterfaceSlice(value)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 0)
+	for _, item := range items {
+		encoded, err := EncodeNested(*typ.Elem, item)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, encoded...)
+	}
+
+	return buf, nil
+}
+
+func encodeTupleElements(typ Type, value interface{}) ([]byte, error) {
+	items, err := toInterfaceSlice(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) != len(typ.TupleElems) {
+		return nil, fmt.Errorf("%w: tuple expects %d elements, got %d", ErrArgsCountMismatch, len(typ.TupleElems), len(items))
+	}
+
+	buf := make([]byte, 0)
+	for i, elemType := range typ.TupleElems {
+		encoded, err := EncodeNested(elemType, items[i])
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, encoded...)
+	}
+
+	return buf, nil
+}
+
+func encodeStructFields(typ Type, value interface{}) ([]byte, error) {
+	fields, err := toFieldMap(value)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 0)
+	for _, field := range typ.StructDef.Fields {
+		fieldValue, found := fields[field.Name]
+		if !found {
+			return nil, fmt.Errorf("%w: missing field %s for struct %s", ErrUnsupportedGoType, field.Name, typ.Name)
+		}
+
+		fieldType, err := ParseType(typ.abi, field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded, err := EncodeNested(fieldType, fieldValue)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, encoded...)
+	}
+
+	return buf, nil
+}
+
+// encodeEnumValue encodes an enum value, given either as a bare string holding the variant name
+// (for variants carrying no fields) or as a map[string]interface{}{"variant": name, "fields": ...}
+// (for variants carrying fields), as a discriminant byte followed by the nested-encoded fields
+func encodeEnumValue(typ Type, value interface{}) ([]byte, error) {
+	variantName, fields, err := toEnumValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	variant, found := findEnumVariantByName(typ.EnumDef, variantName)
+	if !found {
+		return nil, fmt.Errorf("%w: enum %s has no variant %s", ErrInvalidEncodedValue, typ.Name, variantName)
+	}
+
+	buf := []byte{byte(variant.Discriminant)}
+	for _, field := range variant.Fields {
+		fieldValue, found := fields[field.Name]
+		if !found {
+			return nil, fmt.Errorf("%w: missing field %s for variant %s of enum %s", ErrUnsupportedGoType, field.Name, variantName, typ.Name)
+		}
+
+		fieldType, err := ParseType(typ.abi, field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded, err := EncodeNested(fieldType, fieldValue)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, encoded...)
+	}
+
+	return buf, nil
+}
+
+func toEnumValue(value interface{}) (string, map[string]interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil, nil
+	case map[string]interface{}:
+		variantName, ok := v["variant"].(string)
+		if !ok {
+			return "", nil, fmt.Errorf("%w: expected an enum value with a \"variant\" string key", ErrUnsupportedGoType)
+		}
+		fields, _ := v["fields"].(map[string]interface{})
+		return variantName, fields, nil
+	default:
+		return "", nil, fmt.Errorf("%w: expected a string or map[string]interface{} enum value", ErrUnsupportedGoType)
+	}
+}
+
+func findEnumVariantByName(def *TypeDefinition, name string) (EnumVariant, bool) {
+	for _, variant := range def.Variants {
+		if variant.Name == name {
+			return variant, true
+		}
+	}
+	return EnumVariant{}, false
+}
+
+func findEnumVariantByDiscriminant(def *TypeDefinition, discriminant int) (EnumVariant, bool) {
+	for _, variant := range def.Variants {
+		if variant.Discriminant == discriminant {
+			return variant, true
+		}
+	}
+	return EnumVariant{}, false
+}
+
+func encodeScalar(typ Type, value interface{}) ([]byte, error) {
+	switch typ.Kind {
+	case KindBool:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected bool", ErrUnsupportedGoType)
+		}
+		if b {
+			return []byte{0x01}, nil
+		}
+		return []byte{0x00}, nil
+	case KindU8, KindU16, KindU32, KindU64:
+		v, err := toUint64(value)
+		if err != nil {
+			return nil, err
+		}
+		width := fixedWidth(typ.Kind)
+		if width < 8 && v >= uint64(1)<<(uint(width)*8) {
+			return nil, fmt.Errorf("%w: value %d does not fit in %v", ErrInvalidEncodedValue, v, typ.Kind)
+		}
+		return trimmedUint(v, width), nil
+	case KindI8, KindI16, KindI32, KindI64:
+		v, err := toInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		width := fixedWidth(typ.Kind)
+		if width < 8 {
+			max := int64(1) << (uint(width)*8 - 1)
+			if v >= max || v < -max {
+				return nil, fmt.Errorf("%w: value %d does not fit in %v", ErrInvalidEncodedValue, v, typ.Kind)
+			}
+		}
+		return trimmedUint(uint64(v), width), nil
+	case KindBigUint:
+		v, ok := value.(*big.Int)
+		if !ok || v == nil {
+			return nil, fmt.Errorf("%w: expected *big.Int", ErrUnsupportedGoType)
+		}
+		if v.Sign() < 0 {
+			return nil, fmt.Errorf("%w: BigUint cannot encode a negative value", ErrInvalidEncodedValue)
+		}
+		return v.Bytes(), nil
+	case KindBigInt:
+		v, ok := value.(*big.Int)
+		if !ok || v == nil {
+			return nil, fmt.Errorf("%w: expected *big.Int", ErrUnsupportedGoType)
+		}
+		return encodeSignedBigInt(v), nil
+	case KindAddress:
+		addr, ok := value.(addressHandler)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected core.AddressHandler", ErrUnsupportedGoType)
+		}
+		return addr.AddressBytes(), nil
+	case KindTokenIdentifier:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected string", ErrUnsupportedGoType)
+		}
+		return []byte(s), nil
+	case KindBytes:
+		switch v := value.(type) {
+		case []byte:
+			return v, nil
+		case string:
+			return []byte(v), nil
+		default:
+			return nil, fmt.Errorf("%w: expected []byte or string", ErrUnsupportedGoType)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnknownType, typ.Kind)
+	}
+}
+
+// encodeSignedBigInt encodes v using the minimal big-endian two's complement representation,
+// as required by KindBigInt: a positive value gets a leading 0x00 byte whenever its magnitude's
+// high bit is set (so it is not mistaken for a negative value), and a negative value is encoded
+// in the smallest two's complement form whose sign bit is set.
+func encodeSignedBigInt(v *big.Int) []byte {
+	switch v.Sign() {
+	case 0:
+		return []byte{}
+	case 1:
+		b := v.Bytes()
+		if b[0]&0x80 != 0 {
+			b = append([]byte{0x00}, b...)
+		}
+		return b
+	default:
+		abs := new(big.Int).Neg(v)
+		nBytes := (abs.BitLen() + 7) / 8
+		if nBytes == 0 {
+			nBytes = 1
+		}
+		for {
+			mod := new(big.Int).Lsh(big.NewInt(1), uint(nBytes*8))
+			tc := new(big.Int).Add(mod, v).Bytes()
+			if len(tc) < nBytes {
+				padded := make([]byte, nBytes)
+				copy(padded[nBytes-len(tc):], tc)
+				tc = padded
+			}
+			if tc[0]&0x80 != 0 {
+				return tc
+			}
+			nBytes++
+		}
+	}
+}
+
+func fixedWidth(kind Kind) int {
+	switch kind {
+	case KindU8, KindI8:
+		return 1
+	case KindU16, KindI16:
+		return 2
+	case KindU32, KindI32:
+		return 4
+	case KindU64, KindI64:
+		return 8
+	case KindAddress:
+		return 32
+	default:
+		return 8
+	}
+}
+
+func trimmedUint(v uint64, width int) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, v)
+	return buf[8-width:]
+}
+
+func toUint64(value interface{}) (uint64, error) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(rv.Int()), nil
+	default:
+		return 0, fmt.Errorf("%w: expected an unsigned integer", ErrUnsupportedGoType)
+	}
+}
+
+func toInt64(value interface{}) (int64, error) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint()), nil
+	default:
+		return 0, fmt.Errorf("%w: expected a signed integer", ErrUnsupportedGoType)
+	}
+}
+
+func toInterfaceSlice(value interface{}) ([]interface{}, error) {
+	if items, ok := value.([]interface{}); ok {
+		return items, nil
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("%w: expected a slice", ErrUnsupportedGoType)
+	}
+
+	items := make([]interface{}, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		items[i] = rv.Index(i).Interface()
+	}
+
+	return items, nil
+}
+
+func toFieldMap(value interface{}) (map[string]interface{}, error) {
+	if fields, ok := value.(map[string]interface{}); ok {
+		return fields, nil
+	}
+
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: expected a map[string]interface{} or struct", ErrUnsupportedGoType)
+	}
+
+	fields := make(map[string]interface{})
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		fields[rt.Field(i).Name] = rv.Field(i).Interface()
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,27 @@
+package abi
+
+import "errors"
+
+// ErrEndpointNotFound signals that the provided endpoint name does not exist in the ABI
+var ErrEndpointNotFound = errors.New("endpoint not found in abi")
+
+// ErrEventNotFound signals that the provided event identifier does not exist in the ABI
+var ErrEventNotFound = errors.New("event not found in abi")
+
+// ErrUnknownType signals that a type string could not be resolved to a known or user-defined type
+var ErrUnknownType = errors.New("unknown abi type")
+
+// ErrArgsCountMismatch signals that the number of provided arguments does not match the endpoint definition
+var ErrArgsCountMismatch = errors.New("provided arguments count does not match endpoint definition")
+
+// ErrUnsupportedGoType signals that a Go value cannot be encoded as the requested abi type
+var ErrUnsupportedGoType = errors.New("unsupported go type for requested abi type")
+
+// ErrInvalidEncodedValue signals that the encoded bytes could not be decoded as the requested abi type
+var ErrInvalidEncodedValue = errors.New("invalid encoded value for requested abi type")
+
+// ErrNilABI signals that a nil ABI instance was provided
+var ErrNilABI = errors.New("nil abi")
+
+// ErrUnsupportedOutputDestination signals that the provided destination for Decode is not a supported pointer type
+var ErrUnsupportedOutputDestination = errors.New("unsupported output destination")
@@ -0,0 +1,183 @@
+package abi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kind identifies the broad category a parsed abi Type falls into
+type Kind int
+
+// Kind values recognized by the abi type parser
+const (
+	KindU8 Kind = iota
+	KindU16
+	KindU32
+	KindU64
+	KindI8
+	KindI16
+	KindI32
+	KindI64
+	KindBigUint
+	KindBigInt
+	KindBool
+	KindAddress
+	KindTokenIdentifier
+	KindBytes
+	KindOption
+	KindList
+	KindTuple
+	KindStruct
+	KindEnum
+)
+
+// Type is the parsed representation of an abi type string, e.g. "List<Option<BigUint>>"
+type Type struct {
+	Kind Kind
+	Name string // the raw type name, relevant for KindStruct and KindEnum
+
+	// Elem holds the element type for KindOption and KindList
+	Elem *Type
+	// TupleElems holds the component types for KindTuple
+	TupleElems []Type
+	// StructDef/EnumDef hold the resolved user type definition for KindStruct/KindEnum
+	StructDef *TypeDefinition
+	EnumDef   *TypeDefinition
+
+	// abi is kept so that struct/enum fields referencing other user-defined types can be
+	// resolved lazily, without every caller having to thread the originating ABI around
+	abi *ABI
+}
+
+// IsDynamicLength returns true if the encoded length of values of this type is not fixed,
+// meaning nested encodings require a length prefix
+func (t Type) IsDynamicLength() bool {
+	switch t.Kind {
+	case KindBigUint, KindBigInt, KindTokenIdentifier, KindBytes, KindList, KindStruct:
+		return true
+	case KindEnum:
+		// an enum is dynamic only if at least one of its variants carries fields;
+		// conservatively treat all enums as dynamic since fields may vary per variant
+		return len(t.EnumDef.Variants) > 0
+	default:
+		return false
+	}
+}
+
+// ParseType parses a single abi type string (e.g. "BigUint", "List<Address>", "tuple<u32,bytes>")
+// resolving user-defined names against the provided ABI
+func ParseType(abi *ABI, typeStr string) (Type, error) {
+	if abi == nil {
+		return Type{}, ErrNilABI
+	}
+
+	typeStr = strings.TrimSpace(typeStr)
+
+	switch typeStr {
+	case "u8":
+		return Type{Kind: KindU8, abi: abi}, nil
+	case "u16":
+		return Type{Kind: KindU16, abi: abi}, nil
+	case "u32":
+		return Type{Kind: KindU32, abi: abi}, nil
+	case "u64":
+		return Type{Kind: KindU64, abi: abi}, nil
+	case "i8":
+		return Type{Kind: KindI8, abi: abi}, nil
+	case "i16":
+		return Type{Kind: KindI16, abi: abi}, nil
+	case "i32":
+		return Type{Kind: KindI32, abi: abi}, nil
+	case "i64":
+		return Type{Kind: KindI64, abi: abi}, nil
+	case "BigUint":
+		return Type{Kind: KindBigUint, abi: abi}, nil
+	case "BigInt":
+		return Type{Kind: KindBigInt, abi: abi}, nil
+	case "bool":
+		return Type{Kind: KindBool, abi: abi}, nil
+	case "Address":
+		return Type{Kind: KindAddress, abi: abi}, nil
+	case "TokenIdentifier":
+		return Type{Kind: KindTokenIdentifier, abi: abi}, nil
+	case "bytes", "utf-8 string":
+		return Type{Kind: KindBytes, abi: abi}, nil
+	}
+
+	if inner, ok := unwrap(typeStr, "Option<", ">"); ok {
+		elem, err := ParseType(abi, inner)
+		if err != nil {
+			return Type{}, err
+		}
+		return Type{Kind: KindOption, Elem: &elem, abi: abi}, nil
+	}
+
+	if inner, ok := unwrap(typeStr, "List<", ">"); ok {
+		elem, err := ParseType(abi, inner)
+		if err != nil {
+			return Type{}, err
+		}
+		return Type{Kind: KindList, Elem: &elem, abi: abi}, nil
+	}
+
+	if inner, ok := unwrap(typeStr, "tuple<", ">"); ok {
+		parts := splitTopLevel(inner)
+		elems := make([]Type, 0, len(parts))
+		for _, part := range parts {
+			elemType, err := ParseType(abi, part)
+			if err != nil {
+				return Type{}, err
+			}
+			elems = append(elems, elemType)
+		}
+		return Type{Kind: KindTuple, TupleElems: elems, abi: abi}, nil
+	}
+
+	// fall back to a user-defined struct or enum type
+	def, found := abi.Types[typeStr]
+	if !found {
+		return Type{}, fmt.Errorf("%w: %s", ErrUnknownType, typeStr)
+	}
+
+	defCopy := def
+	switch def.Type {
+	case "struct":
+		return Type{Kind: KindStruct, Name: typeStr, StructDef: &defCopy, abi: abi}, nil
+	case "enum":
+		return Type{Kind: KindEnum, Name: typeStr, EnumDef: &defCopy, abi: abi}, nil
+	default:
+		return Type{}, fmt.Errorf("%w: %s has unsupported definition kind %s", ErrUnknownType, typeStr, def.Type)
+	}
+}
+
+func unwrap(s, prefix, suffix string) (string, bool) {
+	if !strings.HasPrefix(s, prefix) || !strings.HasSuffix(s, suffix) {
+		return "", false
+	}
+
+	return s[len(prefix) : len(s)-len(suffix)], true
+}
+
+// splitTopLevel splits a comma-separated type list while respecting nested angle brackets,
+// e.g. "u32,List<Address>,BigUint" -> ["u32", "List<Address>", "BigUint"]
+func splitTopLevel(s string) []string {
+	var parts []string
+	depth := 0
+	start := 0
+	for i, r := range s {
+		switch r {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	parts = append(parts, strings.TrimSpace(s[start:]))
+
+	return parts
+}
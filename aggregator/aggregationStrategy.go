@@ -0,0 +1,130 @@
+package aggregator
+
+import "sort"
+
+// AggregationStrategy combines the individual samples collected from a PriceAggregator's sources
+// into a single price
+type AggregationStrategy interface {
+	Aggregate(samples []Sample) (float64, error)
+	IsInterfaceNil() bool
+}
+
+type medianStrategy struct{}
+
+// NewMedianAggregationStrategy creates an AggregationStrategy that returns the median of the
+// collected samples' prices, ignoring volume
+func NewMedianAggregationStrategy() *medianStrategy {
+	return &medianStrategy{}
+}
+
+// Aggregate returns the median price of the provided samples
+func (m *medianStrategy) Aggregate(samples []Sample) (float64, error) {
+	if len(samples) == 0 {
+		return 0, ErrNoSamplesToAggregate
+	}
+
+	return medianOfSamples(samples), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (m *medianStrategy) IsInterfaceNil() bool {
+	return m == nil
+}
+
+type vwapStrategy struct{}
+
+// NewVWAPAggregationStrategy creates an AggregationStrategy that returns the volume-weighted
+// average price of the collected samples. Samples with zero volume do not contribute to the
+// result; if none of the samples carry volume information, it falls back to a plain average
+func NewVWAPAggregationStrategy() *vwapStrategy {
+	return &vwapStrategy{}
+}
+
+// Aggregate returns the volume-weighted average price of the provided samples
+func (v *vwapStrategy) Aggregate(samples []Sample) (float64, error) {
+	if len(samples) == 0 {
+		return 0, ErrNoSamplesToAggregate
+	}
+
+	var weightedSum, totalVolume float64
+	for _, sample := range samples {
+		weightedSum += sample.Price * sample.Volume
+		totalVolume += sample.Volume
+	}
+
+	if totalVolume == 0 {
+		var sum float64
+		for _, sample := range samples {
+			sum += sample.Price
+		}
+		return sum / float64(len(samples)), nil
+	}
+
+	return weightedSum / totalVolume, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (v *vwapStrategy) IsInterfaceNil() bool {
+	return v == nil
+}
+
+type trimmedMeanStrategy struct {
+	trimCount int
+}
+
+// NewTrimmedMeanAggregationStrategy creates an AggregationStrategy that discards the trimCount
+// lowest and trimCount highest prices before averaging the remainder. It falls back to a plain
+// median whenever there are not enough samples left to trim from both ends
+func NewTrimmedMeanAggregationStrategy(trimCount int) (*trimmedMeanStrategy, error) {
+	if trimCount < 0 {
+		return nil, ErrInvalidTrimCount
+	}
+
+	return &trimmedMeanStrategy{trimCount: trimCount}, nil
+}
+
+// Aggregate returns the trimmed mean price of the provided samples
+func (t *trimmedMeanStrategy) Aggregate(samples []Sample) (float64, error) {
+	if len(samples) == 0 {
+		return 0, ErrNoSamplesToAggregate
+	}
+	if len(samples) <= 2*t.trimCount {
+		return medianOfSamples(samples), nil
+	}
+
+	sorted := sortedPrices(samples)
+	trimmed := sorted[t.trimCount : len(sorted)-t.trimCount]
+
+	var sum float64
+	for _, price := range trimmed {
+		sum += price
+	}
+
+	return sum / float64(len(trimmed)), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (t *trimmedMeanStrategy) IsInterfaceNil() bool {
+	return t == nil
+}
+
+func sortedPrices(samples []Sample) []float64 {
+	prices := make([]float64, len(samples))
+	for i, sample := range samples {
+		prices[i] = sample.Price
+	}
+	sort.Float64s(prices)
+
+	return prices
+}
+
+func medianOfSamples(samples []Sample) float64 {
+	prices := sortedPrices(samples)
+
+	mid := len(prices) / 2
+	if len(prices)%2 == 1 {
+		return prices[mid]
+	}
+
+	return (prices[mid-1] + prices[mid]) / 2
+}
@@ -0,0 +1,94 @@
+package aggregator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMedianStrategy_Aggregate(t *testing.T) {
+	t.Parallel()
+
+	strategy := NewMedianAggregationStrategy()
+
+	t.Run("no samples should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := strategy.Aggregate(nil)
+		require.Equal(t, ErrNoSamplesToAggregate, err)
+	})
+
+	t.Run("odd number of samples", func(t *testing.T) {
+		t.Parallel()
+
+		price, err := strategy.Aggregate([]Sample{{Price: 1}, {Price: 5}, {Price: 3}})
+		require.Nil(t, err)
+		require.Equal(t, float64(3), price)
+	})
+
+	t.Run("even number of samples", func(t *testing.T) {
+		t.Parallel()
+
+		price, err := strategy.Aggregate([]Sample{{Price: 1}, {Price: 2}, {Price: 3}, {Price: 4}})
+		require.Nil(t, err)
+		require.Equal(t, float64(2.5), price)
+	})
+}
+
+func TestVWAPStrategy_Aggregate(t *testing.T) {
+	t.Parallel()
+
+	strategy := NewVWAPAggregationStrategy()
+
+	t.Run("weighted by volume", func(t *testing.T) {
+		t.Parallel()
+
+		price, err := strategy.Aggregate([]Sample{
+			{Price: 10, Volume: 1},
+			{Price: 20, Volume: 3},
+		})
+		require.Nil(t, err)
+		require.Equal(t, 17.5, price)
+	})
+
+	t.Run("falls back to plain average when no volume is reported", func(t *testing.T) {
+		t.Parallel()
+
+		price, err := strategy.Aggregate([]Sample{{Price: 10}, {Price: 20}})
+		require.Nil(t, err)
+		require.Equal(t, float64(15), price)
+	})
+}
+
+func TestTrimmedMeanStrategy_Aggregate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid trim count should error on construction", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewTrimmedMeanAggregationStrategy(-1)
+		require.Equal(t, ErrInvalidTrimCount, err)
+	})
+
+	t.Run("trims the extremes before averaging", func(t *testing.T) {
+		t.Parallel()
+
+		strategy, err := NewTrimmedMeanAggregationStrategy(1)
+		require.Nil(t, err)
+
+		price, err := strategy.Aggregate([]Sample{{Price: 1}, {Price: 2}, {Price: 3}, {Price: 100}})
+		require.Nil(t, err)
+		require.Equal(t, float64(2.5), price)
+	})
+
+	t.Run("falls back to median when there aren't enough samples to trim", func(t *testing.T) {
+		t.Parallel()
+
+		strategy, err := NewTrimmedMeanAggregationStrategy(2)
+		require.Nil(t, err)
+
+		price, err := strategy.Aggregate([]Sample{{Price: 1}, {Price: 2}, {Price: 3}})
+		require.Nil(t, err)
+		require.Equal(t, float64(2), price)
+	})
+}
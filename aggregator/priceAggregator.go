@@ -0,0 +1,210 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+)
+
+// InsufficientSourcesEvent is emitted whenever a FetchPrice call ends up with fewer valid samples
+// than MinSources, so that a caller such as an on-chain price submitter can abstain instead of
+// pushing a price backed by too few sources
+type InsufficientSourcesEvent struct {
+	Base            string
+	Quote           string
+	ValidSources    int
+	RequiredSources int
+}
+
+// InsufficientSourcesHandler receives InsufficientSourcesEvent notifications
+type InsufficientSourcesHandler interface {
+	InsufficientSources(event InsufficientSourcesEvent)
+	IsInterfaceNil() bool
+}
+
+// ArgsPriceAggregator groups the arguments needed to create a PriceAggregator
+type ArgsPriceAggregator struct {
+	Fetchers            []PriceFetcher
+	Strategy            AggregationStrategy
+	FetchTimeout        time.Duration
+	MaxStaleness        time.Duration
+	MinSources          int
+	MaxDeviationPercent float64
+	EventHandler        InsufficientSourcesHandler
+}
+
+// PriceAggregator fans out price requests to a set of PriceFetcher sources and combines the
+// returned samples into a single price using a pluggable AggregationStrategy. Samples are cached
+// per source so that a source which is temporarily slow or failing does not immediately drop out
+// of the aggregation, as long as its last known-good sample is not older than MaxStaleness
+type PriceAggregator struct {
+	fetchers            []PriceFetcher
+	strategy            AggregationStrategy
+	fetchTimeout        time.Duration
+	maxStaleness        time.Duration
+	minSources          int
+	maxDeviationPercent float64
+	eventHandler        InsufficientSourcesHandler
+
+	mut           sync.Mutex
+	cache         map[string]map[string]Sample // pair key -> source name -> last sample
+	runningMedian map[string]float64           // pair key -> last aggregated median
+}
+
+// NewPriceAggregator creates a new PriceAggregator instance
+func NewPriceAggregator(args ArgsPriceAggregator) (*PriceAggregator, error) {
+	if len(args.Fetchers) == 0 {
+		return nil, ErrNoFetchersProvided
+	}
+	if check.IfNil(args.Strategy) {
+		return nil, ErrNilAggregationStrategy
+	}
+	if args.MinSources <= 0 {
+		return nil, ErrInvalidMinSources
+	}
+	if args.FetchTimeout <= 0 {
+		return nil, ErrInvalidFetchTimeout
+	}
+	for _, fetcher := range args.Fetchers {
+		if check.IfNil(fetcher) {
+			return nil, ErrNoFetchersProvided
+		}
+	}
+
+	return &PriceAggregator{
+		fetchers:            args.Fetchers,
+		strategy:            args.Strategy,
+		fetchTimeout:        args.FetchTimeout,
+		maxStaleness:        args.MaxStaleness,
+		minSources:          args.MinSources,
+		maxDeviationPercent: args.MaxDeviationPercent,
+		eventHandler:        args.EventHandler,
+		cache:               make(map[string]map[string]Sample),
+		runningMedian:       make(map[string]float64),
+	}, nil
+}
+
+// FetchPrice refreshes the cached sample of every registered source for the given base/quote
+// pair, discards stale and outlier samples, and returns the aggregated price computed by the
+// configured AggregationStrategy
+func (p *PriceAggregator) FetchPrice(ctx context.Context, base, quote string) (float64, error) {
+	pairKey := pairCacheKey(base, quote)
+
+	p.refreshSamples(ctx, pairKey, base, quote)
+
+	validSamples := p.validSamples(pairKey)
+	if len(validSamples) < p.minSources {
+		p.notifyInsufficientSources(base, quote, len(validSamples))
+		return 0, fmt.Errorf("%w: got %d, need %d", ErrNotEnoughValidSources, len(validSamples), p.minSources)
+	}
+
+	price, err := p.strategy.Aggregate(validSamples)
+	if err != nil {
+		return 0, err
+	}
+
+	p.mut.Lock()
+	p.runningMedian[pairKey] = medianOfSamples(validSamples)
+	p.mut.Unlock()
+
+	return price, nil
+}
+
+func (p *PriceAggregator) refreshSamples(ctx context.Context, pairKey, base, quote string) {
+	var wg sync.WaitGroup
+	wg.Add(len(p.fetchers))
+
+	for _, fetcher := range p.fetchers {
+		go func(fetcher PriceFetcher) {
+			defer wg.Done()
+
+			fetchCtx, cancel := context.WithTimeout(ctx, p.fetchTimeout)
+			defer cancel()
+
+			price, volume, err := fetchPriceAndVolume(fetchCtx, fetcher, base, quote)
+			if err != nil {
+				return
+			}
+
+			p.storeSample(pairKey, Sample{
+				Source:     fetcher.Name(),
+				Price:      price,
+				Volume:     volume,
+				AcquiredAt: time.Now(),
+			})
+		}(fetcher)
+	}
+
+	wg.Wait()
+}
+
+func fetchPriceAndVolume(ctx context.Context, fetcher PriceFetcher, base, quote string) (float64, float64, error) {
+	if volumeFetcher, ok := fetcher.(PriceAndVolumeFetcher); ok {
+		return volumeFetcher.FetchPriceAndVolume(ctx, base, quote)
+	}
+
+	price, err := fetcher.FetchPrice(ctx, base, quote)
+	return price, 0, err
+}
+
+func (p *PriceAggregator) storeSample(pairKey string, sample Sample) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+
+	if _, ok := p.cache[pairKey]; !ok {
+		p.cache[pairKey] = make(map[string]Sample)
+	}
+	p.cache[pairKey][sample.Source] = sample
+}
+
+func (p *PriceAggregator) validSamples(pairKey string) []Sample {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+
+	now := time.Now()
+	median, hasMedian := p.runningMedian[pairKey]
+
+	valid := make([]Sample, 0, len(p.cache[pairKey]))
+	for _, sample := range p.cache[pairKey] {
+		if p.maxStaleness > 0 && now.Sub(sample.AcquiredAt) > p.maxStaleness {
+			continue
+		}
+		if hasMedian && p.maxDeviationPercent > 0 && deviatesTooMuch(sample.Price, median, p.maxDeviationPercent) {
+			continue
+		}
+
+		valid = append(valid, sample)
+	}
+
+	return valid
+}
+
+func (p *PriceAggregator) notifyInsufficientSources(base, quote string, validSources int) {
+	if check.IfNil(p.eventHandler) {
+		return
+	}
+
+	p.eventHandler.InsufficientSources(InsufficientSourcesEvent{
+		Base:            base,
+		Quote:           quote,
+		ValidSources:    validSources,
+		RequiredSources: p.minSources,
+	})
+}
+
+func deviatesTooMuch(price, median, maxDeviationPercent float64) bool {
+	if median == 0 {
+		return false
+	}
+
+	deviation := math.Abs(price-median) / math.Abs(median) * 100
+	return deviation > maxDeviationPercent
+}
+
+func pairCacheKey(base, quote string) string {
+	return base + "-" + quote
+}
@@ -0,0 +1,24 @@
+package aggregator
+
+import "errors"
+
+// ErrNoFetchersProvided signals that a PriceAggregator was configured with no PriceFetcher sources
+var ErrNoFetchersProvided = errors.New("no price fetchers provided")
+
+// ErrNilAggregationStrategy signals that a nil AggregationStrategy has been provided
+var ErrNilAggregationStrategy = errors.New("nil aggregation strategy")
+
+// ErrInvalidMinSources signals that MinSources was configured as zero or negative
+var ErrInvalidMinSources = errors.New("min sources must be greater than zero")
+
+// ErrInvalidFetchTimeout signals that FetchTimeout was configured as zero or negative
+var ErrInvalidFetchTimeout = errors.New("fetch timeout must be greater than zero")
+
+// ErrInvalidTrimCount signals that an AggregationStrategy was configured with a negative trim count
+var ErrInvalidTrimCount = errors.New("trim count must not be negative")
+
+// ErrNoSamplesToAggregate signals that AggregationStrategy.Aggregate was called with no samples
+var ErrNoSamplesToAggregate = errors.New("no samples to aggregate")
+
+// ErrNotEnoughValidSources signals that fewer valid samples were collected than MinSources requires
+var ErrNotEnoughValidSources = errors.New("not enough valid sources to aggregate a price")
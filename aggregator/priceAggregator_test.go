@@ -0,0 +1,202 @@
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fetcherStub struct {
+	name       string
+	price      float64
+	err        error
+	fetchDelay time.Duration
+}
+
+func (f *fetcherStub) FetchPrice(ctx context.Context, _, _ string) (float64, error) {
+	if f.fetchDelay > 0 {
+		select {
+		case <-time.After(f.fetchDelay):
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+
+	return f.price, f.err
+}
+
+func (f *fetcherStub) Name() string { return f.name }
+
+func (f *fetcherStub) IsInterfaceNil() bool { return f == nil }
+
+func createArgsPriceAggregator(fetchers []PriceFetcher) ArgsPriceAggregator {
+	return ArgsPriceAggregator{
+		Fetchers:     fetchers,
+		Strategy:     NewMedianAggregationStrategy(),
+		FetchTimeout: time.Second,
+		MaxStaleness: time.Minute,
+		MinSources:   2,
+	}
+}
+
+func TestNewPriceAggregator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no fetchers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsPriceAggregator(nil)
+		_, err := NewPriceAggregator(args)
+		require.Equal(t, ErrNoFetchersProvided, err)
+	})
+
+	t.Run("nil strategy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsPriceAggregator([]PriceFetcher{&fetcherStub{name: "a"}})
+		args.Strategy = nil
+		_, err := NewPriceAggregator(args)
+		require.Equal(t, ErrNilAggregationStrategy, err)
+	})
+
+	t.Run("invalid min sources should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsPriceAggregator([]PriceFetcher{&fetcherStub{name: "a"}})
+		args.MinSources = 0
+		_, err := NewPriceAggregator(args)
+		require.Equal(t, ErrInvalidMinSources, err)
+	})
+
+	t.Run("invalid fetch timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsPriceAggregator([]PriceFetcher{&fetcherStub{name: "a"}})
+		args.FetchTimeout = 0
+		_, err := NewPriceAggregator(args)
+		require.Equal(t, ErrInvalidFetchTimeout, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsPriceAggregator([]PriceFetcher{&fetcherStub{name: "a"}})
+		aggregator, err := NewPriceAggregator(args)
+		require.Nil(t, err)
+		require.NotNil(t, aggregator)
+	})
+}
+
+func TestPriceAggregator_FetchPrice(t *testing.T) {
+	t.Parallel()
+
+	t.Run("aggregates the median of all sources", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsPriceAggregator([]PriceFetcher{
+			&fetcherStub{name: "a", price: 100},
+			&fetcherStub{name: "b", price: 102},
+			&fetcherStub{name: "c", price: 104},
+		})
+		aggregator, err := NewPriceAggregator(args)
+		require.Nil(t, err)
+
+		price, err := aggregator.FetchPrice(context.Background(), "EGLD", "USD")
+		require.Nil(t, err)
+		require.Equal(t, float64(102), price)
+	})
+
+	t.Run("not enough valid sources should error and notify the handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsPriceAggregator([]PriceFetcher{
+			&fetcherStub{name: "a", price: 100},
+			&fetcherStub{name: "b", err: errors.New("expected error")},
+		})
+
+		var receivedEvent InsufficientSourcesEvent
+		args.EventHandler = &insufficientSourcesHandlerStub{
+			insufficientSourcesCalled: func(event InsufficientSourcesEvent) {
+				receivedEvent = event
+			},
+		}
+		aggregator, err := NewPriceAggregator(args)
+		require.Nil(t, err)
+
+		_, err = aggregator.FetchPrice(context.Background(), "EGLD", "USD")
+		require.ErrorIs(t, err, ErrNotEnoughValidSources)
+		require.Equal(t, 1, receivedEvent.ValidSources)
+		require.Equal(t, 2, receivedEvent.RequiredSources)
+	})
+
+	t.Run("slow sources time out and do not count as valid samples", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsPriceAggregator([]PriceFetcher{
+			&fetcherStub{name: "a", price: 100},
+			&fetcherStub{name: "b", price: 100, fetchDelay: time.Second},
+		})
+		args.FetchTimeout = 10 * time.Millisecond
+
+		aggregator, err := NewPriceAggregator(args)
+		require.Nil(t, err)
+
+		_, err = aggregator.FetchPrice(context.Background(), "EGLD", "USD")
+		require.ErrorIs(t, err, ErrNotEnoughValidSources)
+	})
+
+	t.Run("deviating samples are dropped once a running median exists", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsPriceAggregator([]PriceFetcher{
+			&fetcherStub{name: "a", price: 100},
+			&fetcherStub{name: "b", price: 100},
+		})
+		args.MaxDeviationPercent = 5
+
+		aggregator, err := NewPriceAggregator(args)
+		require.Nil(t, err)
+
+		_, err = aggregator.FetchPrice(context.Background(), "EGLD", "USD")
+		require.Nil(t, err)
+
+		aggregator.fetchers = append(aggregator.fetchers, &fetcherStub{name: "c", price: 1000})
+		price, err := aggregator.FetchPrice(context.Background(), "EGLD", "USD")
+		require.Nil(t, err)
+		require.Equal(t, float64(100), price)
+	})
+
+	t.Run("stale cached samples are dropped", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsPriceAggregator([]PriceFetcher{
+			&fetcherStub{name: "a", err: errors.New("expected error")},
+			&fetcherStub{name: "b", err: errors.New("expected error")},
+		})
+		args.MaxStaleness = time.Millisecond
+
+		aggregator, err := NewPriceAggregator(args)
+		require.Nil(t, err)
+		aggregator.storeSample(pairCacheKey("EGLD", "USD"), Sample{Source: "a", Price: 100, AcquiredAt: time.Now()})
+
+		time.Sleep(5 * time.Millisecond)
+
+		_, err = aggregator.FetchPrice(context.Background(), "EGLD", "USD")
+		require.ErrorIs(t, err, ErrNotEnoughValidSources)
+	})
+}
+
+type insufficientSourcesHandlerStub struct {
+	insufficientSourcesCalled func(event InsufficientSourcesEvent)
+}
+
+func (h *insufficientSourcesHandlerStub) InsufficientSources(event InsufficientSourcesEvent) {
+	if h.insufficientSourcesCalled != nil {
+		h.insufficientSourcesCalled(event)
+	}
+}
+
+func (h *insufficientSourcesHandlerStub) IsInterfaceNil() bool { return h == nil }
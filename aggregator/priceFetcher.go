@@ -0,0 +1,29 @@
+package aggregator
+
+import (
+	"context"
+	"time"
+)
+
+// PriceFetcher defines the behaviour of a component able to fetch the price of a base/quote pair
+// from a single source (an exchange, typically)
+type PriceFetcher interface {
+	FetchPrice(ctx context.Context, base, quote string) (float64, error)
+	Name() string
+	IsInterfaceNil() bool
+}
+
+// PriceAndVolumeFetcher is an optional extension of PriceFetcher for sources that can also report
+// the traded volume alongside the price, such as the ones required by a VWAP aggregation strategy
+type PriceAndVolumeFetcher interface {
+	PriceFetcher
+	FetchPriceAndVolume(ctx context.Context, base, quote string) (price float64, volume float64, err error)
+}
+
+// Sample is a single price observation collected from one PriceFetcher for a base/quote pair
+type Sample struct {
+	Source     string
+	Price      float64
+	Volume     float64
+	AcquiredAt time.Time
+}
@@ -0,0 +1,93 @@
+// Package bind provides a thin contract-binding layer on top of a Proxy and a contract ABI,
+// letting callers invoke or query smart contract endpoints by name instead of manually
+// constructing the "@"-separated data field and parsing raw hex return data themselves.
+package bind
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	builders "github.com/ElrondNetwork/elrond-sdk-erdgo/builders"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/abi"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/blockchain"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// BoundContract wraps a Proxy and a contract ABI, exposing the deployed contract's endpoints
+// by name with ABI-driven argument encoding and result decoding
+type BoundContract struct {
+	proxy       blockchain.Proxy
+	contractABI *abi.ABI
+	address     core.AddressHandler
+}
+
+// NewBoundContract creates a contract binding for the given deployed contract address
+func NewBoundContract(proxy blockchain.Proxy, contractABI *abi.ABI, address core.AddressHandler) (*BoundContract, error) {
+	if check.IfNil(proxy) {
+		return nil, ErrNilProxy
+	}
+	if contractABI == nil {
+		return nil, abi.ErrNilABI
+	}
+	if check.IfNil(address) {
+		return nil, ErrNilAddress
+	}
+
+	return &BoundContract{
+		proxy:       proxy,
+		contractABI: contractABI,
+		address:     address,
+	}, nil
+}
+
+// Query executes a read-only VM query against endpoint and decodes the returned data into out,
+// which must hold one pointer per output declared by the endpoint in the ABI (see abi.Decode)
+func (bc *BoundContract) Query(ctx context.Context, endpoint string, out []interface{}, args ...interface{}) error {
+	builder, err := builders.NewABITxDataBuilder(bc.contractABI)
+	if err != nil {
+		return err
+	}
+
+	vmRequest, err := builder.Address(bc.address).Endpoint(endpoint, args...).ToVmValueRequest()
+	if err != nil {
+		return err
+	}
+
+	response, err := bc.proxy.ExecuteVMQuery(ctx, vmRequest)
+	if err != nil {
+		return err
+	}
+
+	return builder.DecodeOutputs(response.Data.ReturnData, out...)
+}
+
+// Call fills in tx's Data field with the ABI-encoded call to endpoint and broadcasts it through
+// the wrapped Proxy. The caller is expected to have already set the transaction's nonce, gas
+// limit/price, chain ID and signature (e.g. through a TxPipeline)
+func (bc *BoundContract) Call(ctx context.Context, tx *data.Transaction, endpoint string, args ...interface{}) (string, error) {
+	if tx == nil {
+		return "", ErrNilTransaction
+	}
+
+	builder, err := builders.NewABITxDataBuilder(bc.contractABI)
+	if err != nil {
+		return "", err
+	}
+
+	dataBytes, err := builder.Endpoint(endpoint, args...).ToDataBytes()
+	if err != nil {
+		return "", err
+	}
+
+	tx.RcvAddr = bc.address.AddressAsBech32String()
+	tx.Data = dataBytes
+
+	return bc.proxy.SendTransaction(ctx, tx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bc *BoundContract) IsInterfaceNil() bool {
+	return bc == nil
+}
@@ -0,0 +1,122 @@
+package bind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/abi"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/stretchr/testify/require"
+)
+
+const addABIJSON = `{
+	"name": "test",
+	"endpoints": [
+		{"name": "add", "inputs": [{"name": "a", "type": "u32"}, {"name": "b", "type": "u32"}], "outputs": [{"type": "u32"}]}
+	]
+}`
+
+func testContractABI(t *testing.T) *abi.ABI {
+	contractABI, err := abi.NewABIFromJSON([]byte(addABIJSON))
+	require.Nil(t, err)
+	return contractABI
+}
+
+func testContractAddress() *addressStub {
+	return &addressStub{bech32: "erd1contract", bytes: []byte{1, 2, 3, 4}}
+}
+
+func TestNewBoundContract(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewBoundContract(nil, testContractABI(t), testContractAddress())
+		require.Equal(t, ErrNilProxy, err)
+	})
+
+	t.Run("nil abi should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewBoundContract(&proxyStub{}, nil, testContractAddress())
+		require.Equal(t, abi.ErrNilABI, err)
+	})
+
+	t.Run("nil address should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewBoundContract(&proxyStub{}, testContractABI(t), nil)
+		require.Equal(t, ErrNilAddress, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bc, err := NewBoundContract(&proxyStub{}, testContractABI(t), testContractAddress())
+		require.Nil(t, err)
+		require.NotNil(t, bc)
+	})
+}
+
+func TestBoundContract_Query(t *testing.T) {
+	t.Parallel()
+
+	var receivedRequest *data.VmValueRequest
+	proxy := &proxyStub{
+		executeVMQueryCalled: func(_ context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			receivedRequest = vmRequest
+			response := &data.VmValuesResponseData{}
+			response.Data.ReturnData = [][]byte{{0, 0, 0, 7}}
+			return response, nil
+		},
+	}
+
+	bc, err := NewBoundContract(proxy, testContractABI(t), testContractAddress())
+	require.Nil(t, err)
+
+	var sum uint32
+	err = bc.Query(context.Background(), "add", []interface{}{&sum}, uint32(3), uint32(4))
+	require.Nil(t, err)
+	require.Equal(t, uint32(7), sum)
+
+	require.Equal(t, "add", receivedRequest.FuncName)
+	require.Equal(t, "erd1contract", receivedRequest.Address)
+}
+
+func TestBoundContract_Call(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		bc, err := NewBoundContract(&proxyStub{}, testContractABI(t), testContractAddress())
+		require.Nil(t, err)
+
+		_, err = bc.Call(context.Background(), nil, "add", uint32(1), uint32(2))
+		require.Equal(t, ErrNilTransaction, err)
+	})
+
+	t.Run("sets the receiver and data field, then broadcasts", func(t *testing.T) {
+		t.Parallel()
+
+		var sentTx *data.Transaction
+		proxy := &proxyStub{
+			sendTransactionCalled: func(_ context.Context, tx *data.Transaction) (string, error) {
+				sentTx = tx
+				return "txHash", nil
+			},
+		}
+
+		bc, err := NewBoundContract(proxy, testContractABI(t), testContractAddress())
+		require.Nil(t, err)
+
+		tx := &data.Transaction{}
+		hash, err := bc.Call(context.Background(), tx, "add", uint32(1), uint32(2))
+		require.Nil(t, err)
+		require.Equal(t, "txHash", hash)
+		require.Equal(t, "erd1contract", tx.RcvAddr)
+		require.Equal(t, "add@00000001@00000002", string(tx.Data))
+		require.Equal(t, tx, sentTx)
+	})
+}
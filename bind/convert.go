@@ -0,0 +1,33 @@
+package bind
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// AssignValue writes value into destination, which must be a non-nil pointer. It is used by
+// erdbind-generated code to populate strongly typed event/output fields from the
+// interface{} values produced by abi.DecodeTopLevel.
+func AssignValue(destination interface{}, value interface{}) error {
+	rv := reflect.ValueOf(destination)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("%w: destination must be a non-nil pointer", ErrUnsupportedDestination)
+	}
+	if value == nil {
+		return nil
+	}
+
+	target := rv.Elem()
+	source := reflect.ValueOf(value)
+
+	if source.Type().AssignableTo(target.Type()) {
+		target.Set(source)
+		return nil
+	}
+	if source.Type().ConvertibleTo(target.Type()) {
+		target.Set(source.Convert(target.Type()))
+		return nil
+	}
+
+	return fmt.Errorf("%w: cannot assign %s into %s", ErrUnsupportedDestination, source.Type(), target.Type())
+}
@@ -0,0 +1,62 @@
+package bind
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignValue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("non-pointer destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		var destination uint64
+		err := AssignValue(destination, uint64(1))
+		require.ErrorIs(t, err, ErrUnsupportedDestination)
+	})
+
+	t.Run("nil pointer destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		var destination *uint64
+		err := AssignValue(destination, uint64(1))
+		require.ErrorIs(t, err, ErrUnsupportedDestination)
+	})
+
+	t.Run("nil value is a no-op", func(t *testing.T) {
+		t.Parallel()
+
+		destination := uint64(7)
+		err := AssignValue(&destination, nil)
+		require.Nil(t, err)
+		require.Equal(t, uint64(7), destination)
+	})
+
+	t.Run("assignable value is set directly", func(t *testing.T) {
+		t.Parallel()
+
+		var destination string
+		err := AssignValue(&destination, "hello")
+		require.Nil(t, err)
+		require.Equal(t, "hello", destination)
+	})
+
+	t.Run("convertible value is converted", func(t *testing.T) {
+		t.Parallel()
+
+		var destination int64
+		err := AssignValue(&destination, int32(-5))
+		require.Nil(t, err)
+		require.Equal(t, int64(-5), destination)
+	})
+
+	t.Run("unconvertible value should error", func(t *testing.T) {
+		t.Parallel()
+
+		var destination uint64
+		err := AssignValue(&destination, "not a number")
+		require.ErrorIs(t, err, ErrUnsupportedDestination)
+	})
+}
@@ -0,0 +1,15 @@
+package bind
+
+import "errors"
+
+// ErrNilProxy signals that a nil Proxy has been provided
+var ErrNilProxy = errors.New("nil proxy")
+
+// ErrNilAddress signals that a nil address has been provided
+var ErrNilAddress = errors.New("nil address")
+
+// ErrNilTransaction signals that a nil transaction has been provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrUnsupportedDestination signals that the provided destination for AssignValue is not a supported pointer type
+var ErrUnsupportedDestination = errors.New("unsupported destination")
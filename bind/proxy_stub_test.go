@@ -0,0 +1,86 @@
+package bind
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+type addressStub struct {
+	bech32 string
+	bytes  []byte
+}
+
+func (a *addressStub) AddressAsBech32String() string { return a.bech32 }
+
+func (a *addressStub) AddressBytes() []byte { return a.bytes }
+
+func (a *addressStub) IsInterfaceNil() bool { return a == nil }
+
+// proxyStub is a configurable blockchain.Proxy test double: BoundContract only ever calls
+// ExecuteVMQuery (Query) and SendTransaction (Call), so the rest are unused stubs.
+type proxyStub struct {
+	executeVMQueryCalled  func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error)
+	sendTransactionCalled func(ctx context.Context, tx *data.Transaction) (string, error)
+}
+
+func (p *proxyStub) GetNetworkConfig(_ context.Context) (*data.NetworkConfig, error) {
+	return &data.NetworkConfig{}, nil
+}
+
+func (p *proxyStub) GetNetworkStatus(_ context.Context, _ uint32) (*data.NetworkStatus, error) {
+	return &data.NetworkStatus{}, nil
+}
+
+func (p *proxyStub) GetAccount(_ context.Context, _ core.AddressHandler) (*data.Account, error) {
+	return &data.Account{}, nil
+}
+
+func (p *proxyStub) GetStorageValue(_ context.Context, _ core.AddressHandler, _ []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (p *proxyStub) ExecuteVMQuery(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+	return p.executeVMQueryCalled(ctx, vmRequest)
+}
+
+func (p *proxyStub) SendTransaction(ctx context.Context, tx *data.Transaction) (string, error) {
+	return p.sendTransactionCalled(ctx, tx)
+}
+
+func (p *proxyStub) SendTransactions(_ context.Context, _ []*data.Transaction) ([]string, error) {
+	return nil, nil
+}
+
+func (p *proxyStub) GetTransactionStatus(_ context.Context, _ string) (string, error) {
+	return "", nil
+}
+
+func (p *proxyStub) GetTransactionInfoWithResults(_ context.Context, _ string) (*data.TransactionInfo, error) {
+	return &data.TransactionInfo{}, nil
+}
+
+func (p *proxyStub) RequestTransactionCost(_ context.Context, _ *data.Transaction) (*data.TxCostResponseData, error) {
+	return &data.TxCostResponseData{}, nil
+}
+
+func (p *proxyStub) GetLatestHyperBlockNonce(_ context.Context) (uint64, error) {
+	return 0, nil
+}
+
+func (p *proxyStub) GetHyperBlockByNonce(_ context.Context, _ uint64) (*data.HyperBlock, error) {
+	return &data.HyperBlock{}, nil
+}
+
+func (p *proxyStub) GetHyperBlockByHash(_ context.Context, _ string) (*data.HyperBlock, error) {
+	return &data.HyperBlock{}, nil
+}
+
+func (p *proxyStub) GetRawStartOfEpochMetaBlock(_ context.Context, _ uint32) (*data.RawBlockRespone, error) {
+	return &data.RawBlockRespone{}, nil
+}
+
+func (p *proxyStub) IsInterfaceNil() bool {
+	return p == nil
+}
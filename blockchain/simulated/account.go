@@ -0,0 +1,32 @@
+package simulated
+
+import "math/big"
+
+// account holds the in-memory state the simulated backend tracks for a single address
+type account struct {
+	balance *big.Int
+	nonce   uint64
+	code    []byte
+	storage map[string][]byte
+}
+
+func newAccount() *account {
+	return &account{
+		balance: big.NewInt(0),
+		storage: make(map[string][]byte),
+	}
+}
+
+func (a *account) clone() *account {
+	storage := make(map[string][]byte, len(a.storage))
+	for key, value := range a.storage {
+		storage[key] = value
+	}
+
+	return &account{
+		balance: big.NewInt(0).Set(a.balance),
+		nonce:   a.nonce,
+		code:    a.code,
+		storage: storage,
+	}
+}
@@ -0,0 +1,39 @@
+package simulated
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type addressStub struct {
+	bech32 string
+	bytes  []byte
+}
+
+func (a *addressStub) AddressAsBech32String() string { return a.bech32 }
+
+func (a *addressStub) AddressBytes() []byte { return a.bytes }
+
+func (a *addressStub) IsInterfaceNil() bool { return a == nil }
+
+func TestAccount_Clone(t *testing.T) {
+	t.Parallel()
+
+	original := newAccount()
+	original.balance = big.NewInt(100)
+	original.nonce = 3
+	original.code = []byte("code")
+	original.storage["key"] = []byte("value")
+
+	cloned := original.clone()
+	require.Equal(t, original.balance, cloned.balance)
+	require.Equal(t, original.nonce, cloned.nonce)
+	require.Equal(t, original.storage, cloned.storage)
+
+	cloned.balance.SetInt64(200)
+	cloned.storage["key"] = []byte("changed")
+	require.Equal(t, big.NewInt(100), original.balance, "clone must not share the balance pointer")
+	require.Equal(t, []byte("value"), original.storage["key"], "clone must not share the storage map")
+}
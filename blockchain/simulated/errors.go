@@ -0,0 +1,47 @@
+package simulated
+
+import "errors"
+
+// ErrNilCode signals that nil contract code has been provided to DeployContract
+var ErrNilCode = errors.New("nil contract code")
+
+// ErrNilAddress signals that a nil address has been provided where one was required
+var ErrNilAddress = errors.New("nil address")
+
+// ErrAccountNotFound signals that the requested address has no tracked account
+var ErrAccountNotFound = errors.New("account not found")
+
+// ErrInsufficientBalance signals that a sender's balance is too low to cover a transaction's value
+var ErrInsufficientBalance = errors.New("insufficient balance")
+
+// ErrContractNotDeployed signals that a VM query targeted an address with no deployed contract code
+var ErrContractNotDeployed = errors.New("contract not deployed")
+
+// ErrVMExecutionNotSupported signals that the simulated backend was asked to actually run a smart
+// contract's code, which it cannot do since it has no embedded VM execution engine
+var ErrVMExecutionNotSupported = errors.New("VM execution is not supported by the simulated backend")
+
+// ErrNilTransaction signals that a nil transaction has been provided to SendTransaction, or a nil
+// VM query request to ExecuteVMQuery
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrNilOwner signals that a nil owner address has been provided to DeployContract
+var ErrNilOwner = errors.New("nil owner address")
+
+// ErrNilBalance signals that a nil balance has been provided to SetBalance
+var ErrNilBalance = errors.New("nil balance")
+
+// ErrTransactionNotFound signals that the requested transaction hash was never sent through this backend
+var ErrTransactionNotFound = errors.New("transaction not found")
+
+// ErrHyperBlocksNotSupported signals that the simulated backend was asked for hyperblock data, which
+// it does not produce since it has no notion of rounds, shards or consensus
+var ErrHyperBlocksNotSupported = errors.New("hyperblocks are not supported by the simulated backend")
+
+// ErrNilMockContractHandler signals that a nil MockContractHandler has been provided to
+// RegisterMockContractHandler
+var ErrNilMockContractHandler = errors.New("nil mock contract handler")
+
+// ErrInvalidCallData signals that a transaction's data field could not be parsed as a "@"-separated
+// function call
+var ErrInvalidCallData = errors.New("invalid smart contract call data")
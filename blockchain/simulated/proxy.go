@@ -0,0 +1,508 @@
+// Package simulated provides an in-memory implementation of blockchain.Proxy, analogous to
+// go-ethereum's bind/backends/simulated, so that code built on top of erdgo's ABI bindings and
+// tx pipelines can be unit-tested without a running devnet.
+package simulated
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/blockchain"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// dataSeparator matches the "@"-separated call data format builders.ABITxDataBuilder produces
+// and scresults decodes, so a registered MockContractHandler sees the same function name/arguments a
+// real Arwen call would
+const dataSeparator = "@"
+
+// okReturnCode is the hex encoding of "ok", the return code scresults.DecodeCallResult expects
+// for a successful call
+const okReturnCode = "6f6b"
+
+const (
+	numShardsWithoutMeta  = uint32(1)
+	selfShardID           = uint32(0)
+	minTransactionVersion = uint32(1)
+	defaultMinGasPrice    = uint64(1000000000)
+)
+
+// scAddressComputer is the subset of addressGenerator that DeployContract relies on to derive a
+// deployed contract's address the same way the real Arwen VM would
+type scAddressComputer interface {
+	ComputeArwenScAddress(address core.AddressHandler, nonce uint64) (core.AddressHandler, error)
+}
+
+// SimulatedProxy is an in-memory implementation of blockchain.Proxy. It keeps account
+// balances/nonces/storage keyed by bech32 address and accepts data.Transactions the same way a
+// real Proxy would, so ABI-bound contracts and tx pipelines can be exercised against it unchanged.
+//
+// SimulatedProxy does NOT embed an Arwen/WASM VM, and deployed contract bytecode is never itself
+// executed: running real contract code in-process is explicitly out of scope for this backend,
+// not a gap pending follow-up work. A contract's endpoint behavior must instead be hand-written as
+// a Go MockContractHandler and attached via RegisterMockContractHandler; ExecuteVMQuery and
+// SendTransaction calls targeting a contract with a registered handler decode the call's function
+// name and arguments the same way a real node would and invoke the handler in-process against that
+// contract's live storage, producing return data/smart contract results shaped the way a real
+// call's would be. A deployed contract with no registered handler only gets its value/nonce/gas
+// bookkeeping done, and ExecuteVMQuery/SendTransaction return ErrVMExecutionNotSupported for the
+// call itself. See DeployContract for the helper that manufactures a usable contract account, and
+// MockContractHandler's doc comment for why this backend stops short of real VM execution.
+type SimulatedProxy struct {
+	chainID          string
+	hasher           hashing.Hasher
+	addressGenerator scAddressComputer
+
+	mutState  sync.RWMutex
+	accounts  map[string]*account // last committed state
+	pending   map[string]*account // staged by SendTransaction/DeployContract, applied on Commit
+	txs       map[string]*data.Transaction
+	scResults map[string][]*transaction.ApiSmartContractResult // keyed by the same hash as txs
+	handlers  map[string]MockContractHandler                   // keyed by contract bech32 address
+	epoch     uint32
+}
+
+// NewSimulatedProxy creates a simulated backend with no tracked accounts and epoch 0
+func NewSimulatedProxy(chainID string) (*SimulatedProxy, error) {
+	coordinator, err := blockchain.NewShardCoordinator(numShardsWithoutMeta, selfShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	addressGenerator, err := blockchain.NewAddressGenerator(coordinator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SimulatedProxy{
+		chainID:          chainID,
+		hasher:           keccak.NewKeccak(),
+		addressGenerator: addressGenerator,
+		accounts:         make(map[string]*account),
+		pending:          make(map[string]*account),
+		txs:              make(map[string]*data.Transaction),
+		scResults:        make(map[string][]*transaction.ApiSmartContractResult),
+		handlers:         make(map[string]MockContractHandler),
+	}, nil
+}
+
+// SetBalance directly sets an address's committed balance, bypassing the transaction pipeline.
+// It is meant for test setup (funding accounts before exercising a contract), not for simulating
+// network activity.
+func (sp *SimulatedProxy) SetBalance(address core.AddressHandler, balance *big.Int) error {
+	if check.IfNil(address) {
+		return ErrNilAddress
+	}
+	if balance == nil {
+		return ErrNilBalance
+	}
+
+	sp.mutState.Lock()
+	defer sp.mutState.Unlock()
+
+	acc := sp.accountOrNew(sp.accounts, address.AddressAsBech32String())
+	acc.balance = big.NewInt(0).Set(balance)
+	sp.accounts[address.AddressAsBech32String()] = acc
+
+	return nil
+}
+
+// FastForwardEpoch advances the simulated backend's current epoch by n, without producing any
+// intervening blocks
+func (sp *SimulatedProxy) FastForwardEpoch(n uint32) {
+	sp.mutState.Lock()
+	defer sp.mutState.Unlock()
+
+	sp.epoch += n
+}
+
+// DeployContract derives the address the Arwen VM would assign to a contract created by owner
+// (the same computation NewAddressGenerator.ComputeArwenScAddress does for a real deploy),
+// stores code against it and bumps owner's nonce. It applies directly to committed state, so
+// unlike SendTransaction it requires no following Commit call. args is accepted for symmetry with
+// a real deploy transaction's arguments but is not passed to any VM, since none is embedded.
+func (sp *SimulatedProxy) DeployContract(owner core.AddressHandler, code []byte, args ...[]byte) (core.AddressHandler, error) {
+	if check.IfNil(owner) {
+		return nil, ErrNilOwner
+	}
+	if len(code) == 0 {
+		return nil, ErrNilCode
+	}
+
+	sp.mutState.Lock()
+	defer sp.mutState.Unlock()
+
+	ownerAcc := sp.accountOrNew(sp.accounts, owner.AddressAsBech32String())
+
+	scAddress, err := sp.addressGenerator.ComputeArwenScAddress(owner, ownerAcc.nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAcc.nonce++
+	sp.accounts[owner.AddressAsBech32String()] = ownerAcc
+
+	scAcc := newAccount()
+	scAcc.code = code
+	sp.accounts[scAddress.AddressAsBech32String()] = scAcc
+
+	return scAddress, nil
+}
+
+// RegisterMockContractHandler attaches handler as address's endpoint execution logic, so that
+// ExecuteVMQuery and SendTransaction calls targeting it are actually run in-process instead of
+// returning ErrVMExecutionNotSupported. address must already have deployed code (see
+// DeployContract); handler replaces any handler previously registered for it.
+func (sp *SimulatedProxy) RegisterMockContractHandler(address core.AddressHandler, handler MockContractHandler) error {
+	if check.IfNil(address) {
+		return ErrNilAddress
+	}
+	if handler == nil {
+		return ErrNilMockContractHandler
+	}
+
+	sp.mutState.Lock()
+	defer sp.mutState.Unlock()
+
+	acc, ok := sp.accounts[address.AddressAsBech32String()]
+	if !ok || len(acc.code) == 0 {
+		return ErrContractNotDeployed
+	}
+
+	sp.handlers[address.AddressAsBech32String()] = handler
+
+	return nil
+}
+
+// Commit applies every change staged by SendTransaction since the last Commit or Rollback,
+// analogous to mining a block in go-ethereum's simulated backend
+func (sp *SimulatedProxy) Commit() {
+	sp.mutState.Lock()
+	defer sp.mutState.Unlock()
+
+	for addressStr, acc := range sp.pending {
+		sp.accounts[addressStr] = acc
+	}
+	sp.pending = make(map[string]*account)
+}
+
+// Rollback discards every change staged by SendTransaction since the last Commit or Rollback
+func (sp *SimulatedProxy) Rollback() {
+	sp.mutState.Lock()
+	defer sp.mutState.Unlock()
+
+	sp.pending = make(map[string]*account)
+}
+
+// GetNetworkConfig returns the static network configuration the simulated backend was created with
+func (sp *SimulatedProxy) GetNetworkConfig(_ context.Context) (*data.NetworkConfig, error) {
+	sp.mutState.RLock()
+	defer sp.mutState.RUnlock()
+
+	return &data.NetworkConfig{
+		ChainID:               sp.chainID,
+		MinTransactionVersion: minTransactionVersion,
+		NumShardsWithoutMeta:  numShardsWithoutMeta,
+		MinGasPrice:           defaultMinGasPrice,
+	}, nil
+}
+
+// GetNetworkStatus returns the simulated backend's current epoch for the (single) shard it models
+func (sp *SimulatedProxy) GetNetworkStatus(_ context.Context, _ uint32) (*data.NetworkStatus, error) {
+	sp.mutState.RLock()
+	defer sp.mutState.RUnlock()
+
+	return &data.NetworkStatus{
+		CurrentEpoch: sp.epoch,
+	}, nil
+}
+
+// GetAccount returns the committed balance/nonce/code tracked for address, or a freshly zeroed
+// account if none was ever funded or deployed there
+func (sp *SimulatedProxy) GetAccount(_ context.Context, address core.AddressHandler) (*data.Account, error) {
+	if check.IfNil(address) {
+		return nil, ErrNilAddress
+	}
+
+	sp.mutState.RLock()
+	defer sp.mutState.RUnlock()
+
+	acc, ok := sp.accounts[address.AddressAsBech32String()]
+	if !ok {
+		acc = newAccount()
+	}
+
+	return &data.Account{
+		Address: address.AddressAsBech32String(),
+		Nonce:   acc.nonce,
+		Balance: acc.balance.String(),
+		Code:    acc.code,
+	}, nil
+}
+
+// GetStorageValue returns the raw value stored at key for address's committed account, or nil if
+// address has no tracked account or no value was ever stored at key
+func (sp *SimulatedProxy) GetStorageValue(_ context.Context, address core.AddressHandler, key []byte) ([]byte, error) {
+	if check.IfNil(address) {
+		return nil, ErrNilAddress
+	}
+
+	sp.mutState.RLock()
+	defer sp.mutState.RUnlock()
+
+	acc, ok := sp.accounts[address.AddressAsBech32String()]
+	if !ok {
+		return nil, nil
+	}
+
+	return acc.storage[string(key)], nil
+}
+
+// ExecuteVMQuery looks up the queried contract's account and, if it has a MockContractHandler
+// registered via RegisterMockContractHandler, invokes it against the contract's committed storage
+// and returns its ReturnData. If the contract has no registered handler it returns
+// ErrVMExecutionNotSupported instead: the simulated backend tracks deployed code but, absent a
+// handler, has nothing able to run it.
+func (sp *SimulatedProxy) ExecuteVMQuery(_ context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+	if vmRequest == nil {
+		return nil, ErrNilTransaction
+	}
+
+	sp.mutState.RLock()
+	acc, ok := sp.accounts[vmRequest.Address]
+	handler := sp.handlers[vmRequest.Address]
+	sp.mutState.RUnlock()
+
+	if !ok || len(acc.code) == 0 {
+		return nil, ErrContractNotDeployed
+	}
+	if handler == nil {
+		return nil, ErrVMExecutionNotSupported
+	}
+
+	args, err := hexDecodeAll(vmRequest.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := handler(&CallContext{
+		Caller:   vmRequest.CallerAddr,
+		Contract: vmRequest.Address,
+		FuncName: vmRequest.FuncName,
+		Args:     args,
+		Value:    big.NewInt(0),
+		account:  acc.clone(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &data.VmValuesResponseData{}
+	response.Data.ReturnData = result.ReturnData
+
+	return response, nil
+}
+
+// SendTransaction debits tx.Value from the sender's balance, credits the receiver's and stages
+// both accounts' updated state for the next Commit. Gas price/limit are not charged against the
+// sender, since the simulated backend does not meter gas; it only moves value and bumps the
+// sender's nonce, the way a real node would before handing a call off to the VM. If the receiver
+// is a contract with a MockContractHandler registered via RegisterMockContractHandler, tx.Data is parsed
+// as a "function@arg1@..." call and the handler is invoked in-process against the receiver's
+// staged storage, with its outcome recorded as a smart contract result retrievable through
+// GetTransactionInfoWithResults; a contract with no registered handler only gets the value/nonce
+// bookkeeping above, with no result recorded for the call itself. The handler itself runs with no
+// lock held (see ExecuteVMQuery), so it may safely call back into sp's other methods, e.g. to read
+// another contract's state.
+func (sp *SimulatedProxy) SendTransaction(_ context.Context, tx *data.Transaction) (string, error) {
+	if tx == nil {
+		return "", ErrNilTransaction
+	}
+
+	sp.mutState.Lock()
+
+	value, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok {
+		value = big.NewInt(0)
+	}
+
+	sender := sp.accountOrNew(sp.pending, tx.SndAddr)
+	if sender.balance.Cmp(value) < 0 {
+		sp.mutState.Unlock()
+		return "", ErrInsufficientBalance
+	}
+
+	receiver := sp.accountOrNew(sp.pending, tx.RcvAddr)
+
+	sender.balance.Sub(sender.balance, value)
+	sender.nonce++
+	receiver.balance.Add(receiver.balance, value)
+
+	handler, hasHandler := sp.handlers[tx.RcvAddr]
+	sp.mutState.Unlock()
+
+	var scResults []*transaction.ApiSmartContractResult
+	if hasHandler && len(receiver.code) > 0 && len(tx.Data) > 0 {
+		result, err := sp.callMockContractHandler(handler, tx, value, receiver)
+		if err != nil {
+			return "", err
+		}
+		scResults = result
+	}
+
+	sp.mutState.Lock()
+	defer sp.mutState.Unlock()
+
+	sp.pending[tx.SndAddr] = sender
+	sp.pending[tx.RcvAddr] = receiver
+
+	txHash := sp.hasher.Compute(string(tx.Data) + tx.SndAddr + tx.RcvAddr + fmt.Sprintf("%d", tx.Nonce))
+	hexHash := hex.EncodeToString(txHash)
+	sp.txs[hexHash] = tx
+	sp.scResults[hexHash] = scResults
+
+	return hexHash, nil
+}
+
+// callMockContractHandler parses tx.Data as a "function@arg1@..." call and invokes handler against
+// receiver's staged storage, returning the resulting smart contract result wrapped the way
+// scresults.DecodeCallResult expects to find it.
+func (sp *SimulatedProxy) callMockContractHandler(handler MockContractHandler, tx *data.Transaction, value *big.Int, receiver *account) ([]*transaction.ApiSmartContractResult, error) {
+	funcName, args, err := parseCallData(tx.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := handler(&CallContext{
+		Caller:   tx.SndAddr,
+		Contract: tx.RcvAddr,
+		FuncName: funcName,
+		Args:     args,
+		Value:    big.NewInt(0).Set(value),
+		account:  receiver,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []*transaction.ApiSmartContractResult{{
+		SndAddr: tx.RcvAddr,
+		RcvAddr: tx.SndAddr,
+		Data:    encodeCallResultData(result),
+	}}, nil
+}
+
+// SendTransactions calls SendTransaction for each tx in order, collecting their hashes. It stops
+// at the first failure, returning the hashes obtained so far alongside the error.
+func (sp *SimulatedProxy) SendTransactions(ctx context.Context, txs []*data.Transaction) ([]string, error) {
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		hash, err := sp.SendTransaction(ctx, tx)
+		if err != nil {
+			return hashes, err
+		}
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, nil
+}
+
+// GetTransactionStatus returns "success" for any hash returned by a prior SendTransaction, since
+// the simulated backend has no notion of a pending mempool once a tx has been accepted
+func (sp *SimulatedProxy) GetTransactionStatus(_ context.Context, hash string) (string, error) {
+	sp.mutState.RLock()
+	defer sp.mutState.RUnlock()
+
+	if _, ok := sp.txs[hash]; !ok {
+		return "", ErrTransactionNotFound
+	}
+
+	return "success", nil
+}
+
+// GetTransactionInfoWithResults returns the previously sent transaction wrapped the way a real
+// node's API would, together with the smart contract result recorded for it, if the receiver had
+// a MockContractHandler registered for it at the time the transaction was sent
+func (sp *SimulatedProxy) GetTransactionInfoWithResults(_ context.Context, hash string) (*data.TransactionInfo, error) {
+	sp.mutState.RLock()
+	tx, ok := sp.txs[hash]
+	scResults := sp.scResults[hash]
+	sp.mutState.RUnlock()
+
+	if !ok {
+		return nil, ErrTransactionNotFound
+	}
+
+	info := &data.TransactionInfo{}
+	info.Data.Transaction = data.TransactionOnNetwork{
+		Hash:      hash,
+		Nonce:     tx.Nonce,
+		Value:     tx.Value,
+		Receiver:  tx.RcvAddr,
+		Sender:    tx.SndAddr,
+		GasPrice:  tx.GasPrice,
+		GasLimit:  tx.GasLimit,
+		Data:      tx.Data,
+		Status:    "success",
+		ScResults: scResults,
+	}
+
+	return info, nil
+}
+
+// RequestTransactionCost always reports zero cost: the simulated backend does not run the VM, so
+// it has no gas metering to report
+func (sp *SimulatedProxy) RequestTransactionCost(_ context.Context, _ *data.Transaction) (*data.TxCostResponseData, error) {
+	return &data.TxCostResponseData{}, nil
+}
+
+// GetLatestHyperBlockNonce, GetHyperBlockByNonce, GetHyperBlockByHash and
+// GetRawStartOfEpochMetaBlock all return ErrHyperBlocksNotSupported: the simulated backend has no
+// rounds, shards or consensus, so it produces no hyperblocks.
+
+// GetLatestHyperBlockNonce is unsupported, see ErrHyperBlocksNotSupported
+func (sp *SimulatedProxy) GetLatestHyperBlockNonce(_ context.Context) (uint64, error) {
+	return 0, ErrHyperBlocksNotSupported
+}
+
+// GetHyperBlockByNonce is unsupported, see ErrHyperBlocksNotSupported
+func (sp *SimulatedProxy) GetHyperBlockByNonce(_ context.Context, _ uint64) (*data.HyperBlock, error) {
+	return nil, ErrHyperBlocksNotSupported
+}
+
+// GetHyperBlockByHash is unsupported, see ErrHyperBlocksNotSupported
+func (sp *SimulatedProxy) GetHyperBlockByHash(_ context.Context, _ string) (*data.HyperBlock, error) {
+	return nil, ErrHyperBlocksNotSupported
+}
+
+// GetRawStartOfEpochMetaBlock is unsupported, see ErrHyperBlocksNotSupported
+func (sp *SimulatedProxy) GetRawStartOfEpochMetaBlock(_ context.Context, _ uint32) (*data.RawBlockRespone, error) {
+	return nil, ErrHyperBlocksNotSupported
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *SimulatedProxy) IsInterfaceNil() bool {
+	return sp == nil
+}
+
+// accountOrNew returns a clone of the account tracked for addressStr in state if there is one,
+// else a clone of its committed account, or a fresh zeroed account otherwise, ready to be staged
+// into state
+func (sp *SimulatedProxy) accountOrNew(state map[string]*account, addressStr string) *account {
+	if acc, ok := state[addressStr]; ok {
+		return acc.clone()
+	}
+	if acc, ok := sp.accounts[addressStr]; ok {
+		return acc.clone()
+	}
+
+	return newAccount()
+}
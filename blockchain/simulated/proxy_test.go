@@ -0,0 +1,289 @@
+package simulated
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "T"
+
+func newTestProxy(t *testing.T) *SimulatedProxy {
+	sp, err := NewSimulatedProxy(testChainID)
+	require.Nil(t, err)
+	return sp
+}
+
+func TestSimulatedProxy_SetBalance(t *testing.T) {
+	t.Parallel()
+
+	sp := newTestProxy(t)
+	owner := &addressStub{bech32: "owner", bytes: []byte{1}}
+
+	err := sp.SetBalance(owner, big.NewInt(1000))
+	require.Nil(t, err)
+
+	acc, err := sp.GetAccount(context.Background(), owner)
+	require.Nil(t, err)
+	require.Equal(t, "1000", acc.Balance)
+
+	err = sp.SetBalance(owner, nil)
+	require.Equal(t, ErrNilBalance, err)
+
+	err = sp.SetBalance(nil, big.NewInt(1))
+	require.Equal(t, ErrNilAddress, err)
+}
+
+func TestSimulatedProxy_FastForwardEpoch(t *testing.T) {
+	t.Parallel()
+
+	sp := newTestProxy(t)
+	sp.FastForwardEpoch(3)
+
+	status, err := sp.GetNetworkStatus(context.Background(), 0)
+	require.Nil(t, err)
+	require.Equal(t, uint32(3), status.CurrentEpoch)
+}
+
+func TestSimulatedProxy_GetNetworkConfig(t *testing.T) {
+	t.Parallel()
+
+	sp := newTestProxy(t)
+
+	config, err := sp.GetNetworkConfig(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, testChainID, config.ChainID)
+}
+
+func TestSimulatedProxy_DeployAndRegisterMockContractHandler(t *testing.T) {
+	t.Parallel()
+
+	sp := newTestProxy(t)
+	owner := &addressStub{bech32: "owner", bytes: make([]byte, 32)}
+
+	scAddress, err := sp.DeployContract(owner, []byte("code"))
+	require.Nil(t, err)
+	require.NotNil(t, scAddress)
+
+	ownerAcc, err := sp.GetAccount(context.Background(), owner)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), ownerAcc.Nonce, "deploying should bump the owner's nonce")
+
+	t.Run("nil owner should error", func(t *testing.T) {
+		_, err := sp.DeployContract(nil, []byte("code"))
+		require.Equal(t, ErrNilOwner, err)
+	})
+
+	t.Run("nil code should error", func(t *testing.T) {
+		_, err := sp.DeployContract(owner, nil)
+		require.Equal(t, ErrNilCode, err)
+	})
+
+	t.Run("nil handler should error", func(t *testing.T) {
+		err := sp.RegisterMockContractHandler(scAddress, nil)
+		require.Equal(t, ErrNilMockContractHandler, err)
+	})
+
+	t.Run("registering against an address with no deployed code should error", func(t *testing.T) {
+		err := sp.RegisterMockContractHandler(owner, func(*CallContext) (*CallResult, error) { return nil, nil })
+		require.Equal(t, ErrContractNotDeployed, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		err := sp.RegisterMockContractHandler(scAddress, func(*CallContext) (*CallResult, error) { return &CallResult{}, nil })
+		require.Nil(t, err)
+	})
+}
+
+func TestSimulatedProxy_ExecuteVMQuery(t *testing.T) {
+	t.Parallel()
+
+	sp := newTestProxy(t)
+	owner := &addressStub{bech32: "owner", bytes: make([]byte, 32)}
+
+	scAddress, err := sp.DeployContract(owner, []byte("code"))
+	require.Nil(t, err)
+
+	t.Run("no registered handler should error", func(t *testing.T) {
+		_, err := sp.ExecuteVMQuery(context.Background(), &data.VmValueRequest{Address: scAddress.AddressAsBech32String()})
+		require.Equal(t, ErrVMExecutionNotSupported, err)
+	})
+
+	t.Run("undeployed address should error", func(t *testing.T) {
+		_, err := sp.ExecuteVMQuery(context.Background(), &data.VmValueRequest{Address: "nowhere"})
+		require.Equal(t, ErrContractNotDeployed, err)
+	})
+
+	t.Run("invokes the registered handler and returns its data", func(t *testing.T) {
+		var receivedCall *CallContext
+		err := sp.RegisterMockContractHandler(scAddress, func(call *CallContext) (*CallResult, error) {
+			receivedCall = call
+			return &CallResult{ReturnData: [][]byte{{42}}}, nil
+		})
+		require.Nil(t, err)
+
+		response, err := sp.ExecuteVMQuery(context.Background(), &data.VmValueRequest{
+			Address:    scAddress.AddressAsBech32String(),
+			CallerAddr: "caller",
+			FuncName:   "getValue",
+			Args:       []string{"2a"},
+		})
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{{42}}, response.Data.ReturnData)
+
+		require.Equal(t, "caller", receivedCall.Caller)
+		require.Equal(t, "getValue", receivedCall.FuncName)
+		require.Equal(t, [][]byte{{0x2a}}, receivedCall.Args)
+	})
+}
+
+func TestSimulatedProxy_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp := newTestProxy(t)
+		_, err := sp.SendTransaction(context.Background(), nil)
+		require.Equal(t, ErrNilTransaction, err)
+	})
+
+	t.Run("insufficient balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp := newTestProxy(t)
+		_, err := sp.SendTransaction(context.Background(), &data.Transaction{
+			SndAddr: "sender", RcvAddr: "receiver", Value: "100",
+		})
+		require.Equal(t, ErrInsufficientBalance, err)
+	})
+
+	t.Run("moves value and is only visible to GetAccount after Commit", func(t *testing.T) {
+		t.Parallel()
+
+		sp := newTestProxy(t)
+		sender := &addressStub{bech32: "sender", bytes: []byte{1}}
+		require.Nil(t, sp.SetBalance(sender, big.NewInt(1000)))
+
+		hash, err := sp.SendTransaction(context.Background(), &data.Transaction{
+			SndAddr: "sender", RcvAddr: "receiver", Value: "300", Nonce: 0,
+		})
+		require.Nil(t, err)
+		require.NotEmpty(t, hash)
+
+		senderAcc, err := sp.GetAccount(context.Background(), sender)
+		require.Nil(t, err)
+		require.Equal(t, "1000", senderAcc.Balance, "uncommitted transfers must not be visible yet")
+
+		sp.Commit()
+
+		senderAcc, err = sp.GetAccount(context.Background(), sender)
+		require.Nil(t, err)
+		require.Equal(t, "700", senderAcc.Balance)
+
+		receiverAcc, err := sp.GetAccount(context.Background(), &addressStub{bech32: "receiver"})
+		require.Nil(t, err)
+		require.Equal(t, "300", receiverAcc.Balance)
+
+		status, err := sp.GetTransactionStatus(context.Background(), hash)
+		require.Nil(t, err)
+		require.Equal(t, "success", status)
+	})
+
+	t.Run("Rollback discards staged changes", func(t *testing.T) {
+		t.Parallel()
+
+		sp := newTestProxy(t)
+		sender := &addressStub{bech32: "sender", bytes: []byte{1}}
+		require.Nil(t, sp.SetBalance(sender, big.NewInt(1000)))
+
+		_, err := sp.SendTransaction(context.Background(), &data.Transaction{
+			SndAddr: "sender", RcvAddr: "receiver", Value: "300",
+		})
+		require.Nil(t, err)
+
+		sp.Rollback()
+		sp.Commit()
+
+		senderAcc, err := sp.GetAccount(context.Background(), sender)
+		require.Nil(t, err)
+		require.Equal(t, "1000", senderAcc.Balance)
+	})
+
+	t.Run("calls the registered handler and records a smart contract result", func(t *testing.T) {
+		t.Parallel()
+
+		sp := newTestProxy(t)
+		owner := &addressStub{bech32: "owner", bytes: make([]byte, 32)}
+		require.Nil(t, sp.SetBalance(owner, big.NewInt(1000)))
+
+		scAddress, err := sp.DeployContract(owner, []byte("code"))
+		require.Nil(t, err)
+
+		err = sp.RegisterMockContractHandler(scAddress, func(call *CallContext) (*CallResult, error) {
+			call.SetStorage([]byte("k"), []byte("v"))
+			return &CallResult{ReturnData: [][]byte{[]byte("ok")}}, nil
+		})
+		require.Nil(t, err)
+
+		hash, err := sp.SendTransaction(context.Background(), &data.Transaction{
+			SndAddr: "owner", RcvAddr: scAddress.AddressAsBech32String(), Value: "0", Data: []byte("run"),
+		})
+		require.Nil(t, err)
+		sp.Commit()
+
+		info, err := sp.GetTransactionInfoWithResults(context.Background(), hash)
+		require.Nil(t, err)
+		require.Len(t, info.Data.Transaction.ScResults, 1)
+		require.Equal(t, "@6f6b@6f6b", string(info.Data.Transaction.ScResults[0].Data))
+
+		storedValue, err := sp.GetStorageValue(context.Background(), scAddress, []byte("k"))
+		require.Nil(t, err)
+		require.Equal(t, []byte("v"), storedValue)
+	})
+}
+
+func TestSimulatedProxy_GetTransactionStatus_NotFound(t *testing.T) {
+	t.Parallel()
+
+	sp := newTestProxy(t)
+	_, err := sp.GetTransactionStatus(context.Background(), "unknown")
+	require.Equal(t, ErrTransactionNotFound, err)
+
+	_, err = sp.GetTransactionInfoWithResults(context.Background(), "unknown")
+	require.Equal(t, ErrTransactionNotFound, err)
+}
+
+func TestSimulatedProxy_HyperBlocksUnsupported(t *testing.T) {
+	t.Parallel()
+
+	sp := newTestProxy(t)
+
+	_, err := sp.GetLatestHyperBlockNonce(context.Background())
+	require.Equal(t, ErrHyperBlocksNotSupported, err)
+
+	_, err = sp.GetHyperBlockByNonce(context.Background(), 1)
+	require.Equal(t, ErrHyperBlocksNotSupported, err)
+
+	_, err = sp.GetHyperBlockByHash(context.Background(), "hash")
+	require.Equal(t, ErrHyperBlocksNotSupported, err)
+
+	_, err = sp.GetRawStartOfEpochMetaBlock(context.Background(), 1)
+	require.Equal(t, ErrHyperBlocksNotSupported, err)
+}
+
+func TestSimulatedProxy_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var sp *SimulatedProxy
+	require.True(t, sp.IsInterfaceNil())
+
+	sp = newTestProxy(t)
+	require.False(t, sp.IsInterfaceNil())
+}
+
+var _ core.AddressHandler = (*addressStub)(nil)
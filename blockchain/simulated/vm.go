@@ -0,0 +1,100 @@
+package simulated
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// MockContractHandler is a hand-written Go stand-in for a deployed contract's endpoint logic. Its
+// name is deliberate: the simulated backend has no embedded Arwen/WASM engine, so a contract's
+// on-chain bytecode (tracked by DeployContract for address-derivation purposes) is never itself
+// executed, and a MockContractHandler is not a step towards that - it is a mock, registered via
+// RegisterMockContractHandler to run in bytecode's place, and callers needing real WASM execution
+// semantics (gas metering, built-in functions, cross-contract calls) must look elsewhere.
+// Implementations should treat call as read-only when invoked from ExecuteVMQuery (no following
+// Commit will persist storage writes) and are free to read/write the contract's storage when
+// invoked from SendTransaction.
+type MockContractHandler func(call *CallContext) (*CallResult, error)
+
+// CallContext describes a single endpoint invocation handed to a MockContractHandler, together with
+// access to the called contract's storage.
+type CallContext struct {
+	// Caller is the bech32 address that sent the query or transaction
+	Caller string
+	// Contract is the bech32 address of the contract being called
+	Contract string
+	// FuncName is the called endpoint's name
+	FuncName string
+	// Args holds the call's raw (hex-decoded) arguments, in declaration order
+	Args [][]byte
+	// Value is the amount of EGLD sent alongside the call; zero for a VM query
+	Value *big.Int
+
+	account *account
+}
+
+// GetStorage returns the raw value stored at key for the contract being called, or nil if key
+// was never set
+func (c *CallContext) GetStorage(key []byte) []byte {
+	return c.account.storage[string(key)]
+}
+
+// SetStorage stores value at key for the contract being called. Called from a transaction, the
+// write is staged the same way the sender/receiver balance changes are, and becomes visible to
+// other calls only after Commit; called from a query, it has no effect since queries never stage
+// anything.
+func (c *CallContext) SetStorage(key, value []byte) {
+	c.account.storage[string(key)] = value
+}
+
+// CallResult is what a MockContractHandler returns for a successful call
+type CallResult struct {
+	// ReturnData holds the endpoint's raw output values, in declaration order, the same shape
+	// abi.Decode expects for a VM query's return data
+	ReturnData [][]byte
+}
+
+// parseCallData splits data as builders.ABITxDataBuilder formats it - "function@hexArg1@..." -
+// into the called function's name and its raw (hex-decoded) arguments
+func parseCallData(data []byte) (string, [][]byte, error) {
+	parts := strings.Split(string(data), dataSeparator)
+	if len(parts) == 0 || parts[0] == "" {
+		return "", nil, ErrInvalidCallData
+	}
+
+	args, err := hexDecodeAll(parts[1:])
+	if err != nil {
+		return "", nil, err
+	}
+
+	return parts[0], args, nil
+}
+
+// hexDecodeAll hex-decodes each of hexParts, in order
+func hexDecodeAll(hexParts []string) ([][]byte, error) {
+	decoded := make([][]byte, len(hexParts))
+	for i, part := range hexParts {
+		b, err := hex.DecodeString(part)
+		if err != nil {
+			return nil, fmt.Errorf("%w: chunk %d", ErrInvalidCallData, i)
+		}
+		decoded[i] = b
+	}
+
+	return decoded, nil
+}
+
+// encodeCallResultData wraps result the way scresults.DecodeCallResult expects to find a
+// successful top-level call result: a "@"-prefixed, "@"-separated list starting with the hex "ok"
+// return code, followed by result.ReturnData's chunks, each hex-encoded in turn
+func encodeCallResultData(result *CallResult) []byte {
+	parts := make([]string, 0, len(result.ReturnData)+1)
+	parts = append(parts, okReturnCode)
+	for _, chunk := range result.ReturnData {
+		parts = append(parts, hex.EncodeToString(chunk))
+	}
+
+	return []byte(dataSeparator + strings.Join(parts, dataSeparator))
+}
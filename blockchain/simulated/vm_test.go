@@ -0,0 +1,50 @@
+package simulated
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCallData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty data should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := parseCallData(nil)
+		require.Equal(t, ErrInvalidCallData, err)
+	})
+
+	t.Run("parses function name and hex-decoded arguments", func(t *testing.T) {
+		t.Parallel()
+
+		funcName, args, err := parseCallData([]byte("transfer@0000000a@68656c6c6f"))
+		require.Nil(t, err)
+		require.Equal(t, "transfer", funcName)
+		require.Equal(t, [][]byte{{0, 0, 0, 10}, []byte("hello")}, args)
+	})
+
+	t.Run("function with no arguments", func(t *testing.T) {
+		t.Parallel()
+
+		funcName, args, err := parseCallData([]byte("ping"))
+		require.Nil(t, err)
+		require.Equal(t, "ping", funcName)
+		require.Empty(t, args)
+	})
+
+	t.Run("invalid hex argument should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := parseCallData([]byte("transfer@zz"))
+		require.ErrorIs(t, err, ErrInvalidCallData)
+	})
+}
+
+func TestEncodeCallResultData(t *testing.T) {
+	t.Parallel()
+
+	data := encodeCallResultData(&CallResult{ReturnData: [][]byte{{0, 0, 0, 10}, []byte("hi")}})
+	require.Equal(t, "@6f6b@0000000a@6869", string(data))
+}
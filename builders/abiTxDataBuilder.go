@@ -0,0 +1,154 @@
+package elrond
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/abi"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// ABITxDataBuilder can be used to build a smart contract call data field and to decode the
+// corresponding query/result values, using a contract's ABI definition to validate argument
+// count/types instead of requiring the caller to know the on-chain encoding rules
+type ABITxDataBuilder struct {
+	contractABI *abi.ABI
+	address     string
+	function    string
+	callerAddr  string
+	args        []string
+	err         error
+}
+
+// NewABITxDataBuilder creates a new ABI-aware transaction data builder for the provided contract ABI
+func NewABITxDataBuilder(contractABI *abi.ABI) (*ABITxDataBuilder, error) {
+	if contractABI == nil {
+		return nil, abi.ErrNilABI
+	}
+
+	return &ABITxDataBuilder{
+		contractABI: contractABI,
+	}, nil
+}
+
+// CallerAddress sets the caller address
+func (builder *ABITxDataBuilder) CallerAddress(address core.AddressHandler) *ABITxDataBuilder {
+	err := builder.checkAddress(address)
+	if err != nil {
+		builder.err = err
+		return builder
+	}
+
+	builder.callerAddr = address.AddressAsBech32String()
+
+	return builder
+}
+
+// Address sets the destination address
+func (builder *ABITxDataBuilder) Address(address core.AddressHandler) *ABITxDataBuilder {
+	err := builder.checkAddress(address)
+	if err != nil {
+		builder.err = err
+		return builder
+	}
+
+	builder.address = address.AddressAsBech32String()
+
+	return builder
+}
+
+func (builder *ABITxDataBuilder) checkAddress(address core.AddressHandler) error {
+	if check.IfNil(address) {
+		return fmt.Errorf("%w in ABITxDataBuilder.checkAddress", ErrNilAddress)
+	}
+	if len(address.AddressBytes()) == 0 {
+		return fmt.Errorf("%w in ABITxDataBuilder.checkAddress", ErrInvalidAddress)
+	}
+
+	return nil
+}
+
+// Endpoint sets the endpoint to call and ABI-encodes the provided arguments against its inputs.
+// args must match the endpoint's declared inputs in count and in Go type (see abi.EncodeTopLevel)
+func (builder *ABITxDataBuilder) Endpoint(endpoint string, args ...interface{}) *ABITxDataBuilder {
+	endpointDef, err := builder.contractABI.Endpoint(endpoint)
+	if err != nil {
+		builder.err = err
+		return builder
+	}
+	if len(endpointDef.Inputs) != len(args) {
+		builder.err = fmt.Errorf("%w: endpoint %s declares %d inputs, got %d arguments",
+			abi.ErrArgsCountMismatch, endpoint, len(endpointDef.Inputs), len(args))
+		return builder
+	}
+
+	encodedArgs := make([]string, 0, len(args))
+	for i, input := range endpointDef.Inputs {
+		argType, err := abi.ParseType(builder.contractABI, input.Type)
+		if err != nil {
+			builder.err = err
+			return builder
+		}
+
+		encoded, err := abi.EncodeTopLevel(argType, args[i])
+		if err != nil {
+			builder.err = fmt.Errorf("%w for argument %s of endpoint %s", err, input.Name, endpoint)
+			return builder
+		}
+		encodedArgs = append(encodedArgs, hex.EncodeToString(encoded))
+	}
+
+	builder.function = endpoint
+	builder.args = encodedArgs
+
+	return builder
+}
+
+// ToDataString returns the formatted data string ready to be used in a transaction call
+func (builder *ABITxDataBuilder) ToDataString() (string, error) {
+	if builder.err != nil {
+		return "", builder.err
+	}
+
+	parts := append([]string{builder.function}, builder.args...)
+
+	return strings.Join(parts, dataSeparator), nil
+}
+
+// ToDataBytes returns the formatted data string ready to be used in a transaction call as bytes
+func (builder *ABITxDataBuilder) ToDataBytes() ([]byte, error) {
+	dataField, err := builder.ToDataString()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(dataField), nil
+}
+
+// ToVmValueRequest returns the VmValueRequest structure to be used in a VM query
+func (builder *ABITxDataBuilder) ToVmValueRequest() (*data.VmValueRequest, error) {
+	if builder.err != nil {
+		return nil, builder.err
+	}
+
+	return &data.VmValueRequest{
+		Address:    builder.address,
+		FuncName:   builder.function,
+		CallerAddr: builder.callerAddr,
+		Args:       builder.args,
+	}, nil
+}
+
+// DecodeOutputs decodes the return data chunks produced by executing the endpoint set via Endpoint,
+// writing the typed values into out (see abi.Decode)
+func (builder *ABITxDataBuilder) DecodeOutputs(vmOutputReturnData [][]byte, out ...interface{}) error {
+	return abi.Decode(builder.contractABI, builder.function, vmOutputReturnData, out...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (builder *ABITxDataBuilder) IsInterfaceNil() bool {
+	return builder == nil
+}
@@ -0,0 +1,517 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/abi"
+)
+
+// Bind generates a Go source file exposing one strongly-typed Caller/Transactor method per
+// endpoint declared in contractABI, plus a decoder for each declared event, mirroring the
+// approach of go-ethereum's abigen but built on top of erdgo's abi and bind packages.
+func Bind(contractABI *abi.ABI, pkgName string, typeName string, rawABI []byte) ([]byte, error) {
+	if contractABI == nil {
+		return nil, abi.ErrNilABI
+	}
+	if typeName == "" {
+		typeName = exportedName(contractABI.Name)
+	}
+	if typeName == "" {
+		return nil, fmt.Errorf("unable to derive a contract type name, pass -type explicitly")
+	}
+
+	data := &bindTemplateData{
+		PackageName: pkgName,
+		TypeName:    typeName,
+		MetaData:    fmt.Sprintf("%#v", string(rawABI)),
+	}
+
+	for _, name := range sortedTypeNames(contractABI.Types) {
+		def := contractABI.Types[name]
+		if def.Type != "struct" {
+			// enums decode to either a bare variant name string or a map[string]interface{}
+			// (see abi.DecodeTopLevel), so there is no dedicated Go type to generate for them
+			continue
+		}
+
+		fields := make([]bindField, 0, len(def.Fields))
+		for _, field := range def.Fields {
+			fieldType, err := abi.ParseType(contractABI, field.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%w while resolving field %s of type %s", err, field.Name, name)
+			}
+			fields = append(fields, bindField{Name: exportedName(field.Name), GoType: goTypeForOut(fieldType)})
+		}
+
+		data.Structs = append(data.Structs, bindStruct{Name: exportedName(name), Fields: fields})
+	}
+
+	for _, endpoint := range contractABI.Endpoints {
+		args := make([]bindArg, 0, len(endpoint.Inputs))
+		for i, input := range endpoint.Inputs {
+			argType, err := abi.ParseType(contractABI, input.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%w while resolving input %s of endpoint %s", err, input.Name, endpoint.Name)
+			}
+			args = append(args, bindArg{Name: argName(input.Name, i), GoType: goTypeForArg(argType)})
+		}
+
+		outs := make([]bindArg, 0, len(endpoint.Outputs))
+		for i, output := range endpoint.Outputs {
+			outType, err := abi.ParseType(contractABI, output.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%w while resolving output %d of endpoint %s", err, i, endpoint.Name)
+			}
+			outs = append(outs, bindArg{Name: argName(output.Name, i), GoType: goTypeForOut(outType)})
+		}
+
+		method := bindMethod{
+			GoName:       exportedName(endpoint.Name),
+			EndpointName: endpoint.Name,
+			Args:         args,
+			Outs:         outs,
+			IsReadOnly:   endpoint.Mutability == "readonly",
+		}
+
+		if method.IsReadOnly {
+			data.Callers = append(data.Callers, method)
+		} else {
+			data.Transactors = append(data.Transactors, method)
+		}
+	}
+
+	for _, event := range contractABI.Events {
+		ev := bindEvent{
+			GoName:     exportedName(event.Identifier),
+			Identifier: event.Identifier,
+		}
+
+		for _, input := range event.Inputs {
+			fieldType, err := abi.ParseType(contractABI, input.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%w while resolving event field %s of %s", err, input.Name, event.Identifier)
+			}
+			field := bindEventField{
+				Name:         exportedName(input.Name),
+				OriginalName: input.Name,
+				GoType:       goTypeForOut(fieldType),
+				Indexed:      input.Indexed,
+			}
+			ev.Fields = append(ev.Fields, field)
+		}
+
+		data.Events = append(data.Events, ev)
+	}
+
+	data.HasTransactors = len(data.Transactors) > 0
+	data.NeedsBigInt = usesBigInt(data)
+
+	var buf bytes.Buffer
+	if err := bindTemplate.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("%w while executing bind template", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%w while formatting generated source, template output was:\n%s", err, buf.String())
+	}
+
+	return formatted, nil
+}
+
+// usesBigInt reports whether any generated signature or struct references *big.Int, so the
+// template can omit the math/big import otherwise
+func usesBigInt(data *bindTemplateData) bool {
+	for _, s := range data.Structs {
+		for _, f := range s.Fields {
+			if strings.Contains(f.GoType, "big.Int") {
+				return true
+			}
+		}
+	}
+	for _, methods := range [][]bindMethod{data.Callers, data.Transactors} {
+		for _, m := range methods {
+			for _, a := range m.Args {
+				if strings.Contains(a.GoType, "big.Int") {
+					return true
+				}
+			}
+			for _, o := range m.Outs {
+				if strings.Contains(o.GoType, "big.Int") {
+					return true
+				}
+			}
+		}
+	}
+	for _, e := range data.Events {
+		for _, f := range e.Fields {
+			if strings.Contains(f.GoType, "big.Int") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func sortedTypeNames(types map[string]abi.TypeDefinition) []string {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// exportedName turns a camelCase or snake_case ABI identifier into an exported Go identifier
+func exportedName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool { return r == '_' })
+
+	var b strings.Builder
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]))
+		b.WriteString(part[1:])
+	}
+
+	return b.String()
+}
+
+// argName returns a usable Go parameter/field name for an ABI input/output, falling back to a
+// positional name when the ABI does not provide one (common for endpoint outputs)
+func argName(name string, index int) string {
+	if name == "" {
+		return fmt.Sprintf("out%d", index)
+	}
+
+	exported := exportedName(name)
+	// lower-case the first rune so generated parameter names do not stutter against the
+	// exported struct/method names they sit next to
+	return strings.ToLower(exported[:1]) + exported[1:]
+}
+
+// goTypeForArg maps a parsed abi.Type to the Go type used for an endpoint *input* (what a
+// caller passes to abi.EncodeTopLevel). Address arguments are accepted as core.AddressHandler,
+// matching encodeScalar's addressHandler requirement, and lists recur into their element type
+// since EncodeNested walks a real Go slice of that type.
+func goTypeForArg(typ abi.Type) string {
+	switch typ.Kind {
+	case abi.KindAddress:
+		return "core.AddressHandler"
+	case abi.KindList:
+		return "[]" + goTypeForArg(*typ.Elem)
+	default:
+		return goTypeCommon(typ)
+	}
+}
+
+// goTypeForOut maps a parsed abi.Type to the Go type used for an endpoint *output* or event
+// field (what abi.DecodeTopLevel produces). decodeScalar always returns raw []byte for
+// addresses, and decodeListFromRaw always returns a flat []interface{} regardless of the
+// element type, so those two kinds need a different mapping than on the argument side.
+func goTypeForOut(typ abi.Type) string {
+	switch typ.Kind {
+	case abi.KindAddress:
+		return "[]byte"
+	case abi.KindList:
+		return "[]interface{}"
+	default:
+		return goTypeCommon(typ)
+	}
+}
+
+// goTypeCommon maps the abi.Type kinds whose encoded/decoded Go representation is identical in
+// both directions. Types whose shape depends on runtime data (Option, Tuple, Struct, Enum) are
+// represented as interface{}, matching what abi.EncodeTopLevel/DecodeTopLevel accept and produce.
+func goTypeCommon(typ abi.Type) string {
+	switch typ.Kind {
+	case abi.KindU8:
+		return "uint8"
+	case abi.KindU16:
+		return "uint16"
+	case abi.KindU32:
+		return "uint32"
+	case abi.KindU64:
+		return "uint64"
+	case abi.KindI8:
+		return "int8"
+	case abi.KindI16:
+		return "int16"
+	case abi.KindI32:
+		return "int32"
+	case abi.KindI64:
+		return "int64"
+	case abi.KindBigUint, abi.KindBigInt:
+		return "*big.Int"
+	case abi.KindBool:
+		return "bool"
+	case abi.KindTokenIdentifier:
+		return "string"
+	case abi.KindBytes:
+		return "[]byte"
+	default:
+		// KindOption, KindTuple, KindStruct and KindEnum decode to a shape that depends on the
+		// concrete value (nil vs. present, a positional slice, a fields map keyed by the ABI
+		// field names, a bare string for simple enum variants), so callers build/consume these
+		// the same way they would through the abi package directly; the generated struct below
+		// (when Kind is KindStruct) documents the expected field names and types for that map
+		return "interface{}"
+	}
+}
+
+type bindTemplateData struct {
+	PackageName    string
+	TypeName       string
+	MetaData       string
+	Structs        []bindStruct
+	Callers        []bindMethod
+	Transactors    []bindMethod
+	Events         []bindEvent
+	HasTransactors bool
+	NeedsBigInt    bool
+}
+
+type bindStruct struct {
+	Name   string
+	Fields []bindField
+}
+
+type bindField struct {
+	Name   string
+	GoType string
+}
+
+type bindArg struct {
+	Name   string
+	GoType string
+}
+
+type bindMethod struct {
+	GoName       string
+	EndpointName string
+	Args         []bindArg
+	Outs         []bindArg
+	IsReadOnly   bool
+}
+
+type bindEventField struct {
+	Name         string
+	OriginalName string
+	GoType       string
+	Indexed      bool
+}
+
+type bindEvent struct {
+	GoName     string
+	Identifier string
+	Fields     []bindEventField
+}
+
+var bindTemplate = template.Must(template.New("bind").Parse(bindSourceTemplate))
+
+const bindSourceTemplate = `// Code generated by erdbind. DO NOT EDIT.
+
+package {{.PackageName}}
+
+import (
+	"context"
+{{- if .NeedsBigInt}}
+	"math/big"
+{{- end}}
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/abi"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/bind"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/blockchain"
+{{- if .HasTransactors}}
+	builders "github.com/ElrondNetwork/elrond-sdk-erdgo/builders"
+{{- end}}
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+{{- if .HasTransactors}}
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/interactors"
+{{- end}}
+)
+
+// {{.TypeName}}MetaData is the raw contract ABI json {{.TypeName}} was generated from
+const {{.TypeName}}MetaData = {{.MetaData}}
+
+{{range .Structs}}
+// {{.Name}} documents the field names and types of the ABI struct of the same name. Arguments
+// and decoded values of this type are passed/returned as map[string]interface{} keyed by the
+// ABI field name (not the exported names below), matching abi.EncodeTopLevel/DecodeTopLevel.
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.GoType}}
+{{- end}}
+}
+{{end}}
+
+// {{.TypeName}}Caller exposes the read-only endpoints of {{.TypeName}}
+type {{.TypeName}}Caller struct {
+	contract *bind.BoundContract
+}
+
+// {{.TypeName}}Transactor exposes the state-mutating endpoints of {{.TypeName}}
+type {{.TypeName}}Transactor struct {
+	contract    *bind.BoundContract
+	contractABI *abi.ABI
+	address     core.AddressHandler
+	proxy       blockchain.Proxy
+}
+
+// {{.TypeName}} binds a deployed contract's endpoints and events to Go, combining a
+// {{.TypeName}}Caller and a {{.TypeName}}Transactor
+type {{.TypeName}} struct {
+	{{.TypeName}}Caller
+	{{.TypeName}}Transactor
+}
+
+// New{{.TypeName}} creates a binding for a {{.TypeName}} contract deployed at address
+func New{{.TypeName}}(address core.AddressHandler, proxy blockchain.Proxy) (*{{.TypeName}}, error) {
+	contractABI, err := abi.NewABIFromJSON([]byte({{.TypeName}}MetaData))
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := bind.NewBoundContract(proxy, contractABI, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{.TypeName}}{
+		{{.TypeName}}Caller:      {{.TypeName}}Caller{contract: contract},
+		{{.TypeName}}Transactor: {{.TypeName}}Transactor{contract: contract, contractABI: contractABI, address: address, proxy: proxy},
+	}, nil
+}
+
+{{range .Callers}}
+// {{.GoName}} calls the read-only "{{.EndpointName}}" endpoint
+func (c *{{$.TypeName}}Caller) {{.GoName}}(ctx context.Context{{range .Args}}, {{.Name}} {{.GoType}}{{end}}) ({{range .Outs}}{{.GoType}}, {{end}}error) {
+	out := make([]interface{}, {{len .Outs}})
+{{- range $i, $o := .Outs}}
+	var {{$o.Name}} {{$o.GoType}}
+	out[{{$i}}] = &{{$o.Name}}
+{{- end}}
+
+	err := c.contract.Query(ctx, "{{.EndpointName}}", out{{range .Args}}, {{.Name}}{{end}})
+	return {{range .Outs}}{{.Name}}, {{end}}err
+}
+{{end}}
+
+{{range .Transactors}}
+// {{.GoName}} signs and sends a transaction calling the "{{.EndpointName}}" endpoint
+func (t *{{$.TypeName}}Transactor) {{.GoName}}(ctx context.Context, tx *data.Transaction, signer interactors.TxSigner, privateKeyBytes []byte{{range .Args}}, {{.Name}} {{.GoType}}{{end}}) (string, error) {
+	builder, err := builders.NewABITxDataBuilder(t.contractABI)
+	if err != nil {
+		return "", err
+	}
+
+	dataBytes, err := builder.Endpoint("{{.EndpointName}}"{{range .Args}}, {{.Name}}{{end}}).ToDataBytes()
+	if err != nil {
+		return "", err
+	}
+
+	tx.RcvAddr = t.address.AddressAsBech32String()
+	tx.Data = dataBytes
+
+	signature, err := signer.SignTransaction(tx, privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+	tx.Signature = signature
+
+	return t.proxy.SendTransaction(ctx, tx)
+}
+{{end}}
+
+{{range .Events}}
+// {{.GoName}}Event holds the decoded fields of the "{{.Identifier}}" event
+type {{.GoName}}Event struct {
+{{- range .Fields}}
+	{{.Name}} {{.GoType}}
+{{- end}}
+}
+
+// Parse{{.GoName}}Event decodes a "{{.Identifier}}" event from its log topics and data payload,
+// matching indexed fields against topics[1:] in declaration order and non-indexed fields against
+// the data payload
+func Parse{{.GoName}}Event(contractABI *abi.ABI, topics [][]byte, logData []byte) (*{{.GoName}}Event, error) {
+	event, err := contractABI.Event("{{.Identifier}}")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &{{.GoName}}Event{}
+	var nonIndexedTypes []abi.Type
+	var nonIndexedAssign []func(interface{}) error
+	topicIdx := 1
+
+	for _, input := range event.Inputs {
+		fieldType, err := abi.ParseType(contractABI, input.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		if input.Indexed {
+			if topicIdx >= len(topics) {
+				return nil, abi.ErrInvalidEncodedValue
+			}
+			value, decErr := abi.DecodeTopLevel(fieldType, topics[topicIdx])
+			if decErr != nil {
+				return nil, decErr
+			}
+			topicIdx++
+
+			switch input.Name {
+{{- range .Fields}}
+			case "{{.OriginalName}}":
+				if err = bind.AssignValue(&result.{{.Name}}, value); err != nil {
+					return nil, err
+				}
+{{- end}}
+			}
+			continue
+		}
+
+		nonIndexedTypes = append(nonIndexedTypes, fieldType)
+		name := input.Name
+		nonIndexedAssign = append(nonIndexedAssign, func(value interface{}) error {
+			switch name {
+{{- range .Fields}}
+			case "{{.OriginalName}}":
+				return bind.AssignValue(&result.{{.Name}}, value)
+{{- end}}
+			}
+			return nil
+		})
+	}
+
+	if len(nonIndexedTypes) > 0 {
+		tupleType := abi.Type{Kind: abi.KindTuple, TupleElems: nonIndexedTypes}
+		decoded, err := abi.DecodeTopLevel(tupleType, logData)
+		if err != nil {
+			return nil, err
+		}
+		values, ok := decoded.([]interface{})
+		if !ok {
+			return nil, abi.ErrInvalidEncodedValue
+		}
+		for i, value := range values {
+			if err = nonIndexedAssign[i](value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+{{end}}
+`
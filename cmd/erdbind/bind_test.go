@@ -0,0 +1,147 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/abi"
+)
+
+func testContractABI() *abi.ABI {
+	contractABI, err := abi.NewABIFromJSON([]byte(`{
+		"name": "adder",
+		"endpoints": [
+			{"name": "getSum", "mutability": "readonly", "inputs": [], "outputs": [{"type": "BigUint"}]},
+			{"name": "add", "mutability": "mutable", "inputs": [{"name": "new_value", "type": "BigUint"}], "outputs": []}
+		],
+		"events": [
+			{
+				"identifier": "add_event",
+				"inputs": [
+					{"name": "caller", "type": "Address", "indexed": true},
+					{"name": "new_value", "type": "BigUint", "indexed": false}
+				]
+			}
+		],
+		"types": {
+			"Reward": {
+				"type": "struct",
+				"fields": [
+					{"name": "token", "type": "TokenIdentifier"},
+					{"name": "amount", "type": "BigUint"}
+				]
+			}
+		}
+	}`))
+	if err != nil {
+		panic(err)
+	}
+
+	return contractABI
+}
+
+func TestExportedName(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "GetSum", exportedName("getSum"))
+	require.Equal(t, "NewValue", exportedName("new_value"))
+	require.Equal(t, "AddEvent", exportedName("add_event"))
+}
+
+func TestArgName(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "newValue", argName("new_value", 0))
+	require.Equal(t, "out0", argName("", 0))
+}
+
+func TestGoTypeForArg(t *testing.T) {
+	t.Parallel()
+
+	contractABI := testContractABI()
+
+	bigUintType, err := abi.ParseType(contractABI, "BigUint")
+	require.Nil(t, err)
+	require.Equal(t, "*big.Int", goTypeForArg(bigUintType))
+
+	listType, err := abi.ParseType(contractABI, "List<Address>")
+	require.Nil(t, err)
+	require.Equal(t, "[]core.AddressHandler", goTypeForArg(listType))
+
+	structType, err := abi.ParseType(contractABI, "Reward")
+	require.Nil(t, err)
+	require.Equal(t, "interface{}", goTypeForArg(structType))
+
+	optionType, err := abi.ParseType(contractABI, "Option<u32>")
+	require.Nil(t, err)
+	require.Equal(t, "interface{}", goTypeForArg(optionType))
+}
+
+func TestGoTypeForOut(t *testing.T) {
+	t.Parallel()
+
+	contractABI := testContractABI()
+
+	// decodeScalar always returns raw []byte for addresses and decodeListFromRaw always
+	// returns a flat []interface{}, regardless of the declared element type, so the output
+	// side of the mapping must differ from the argument side for these two kinds
+	addressType, err := abi.ParseType(contractABI, "Address")
+	require.Nil(t, err)
+	require.Equal(t, "[]byte", goTypeForOut(addressType))
+
+	listType, err := abi.ParseType(contractABI, "List<Address>")
+	require.Nil(t, err)
+	require.Equal(t, "[]interface{}", goTypeForOut(listType))
+
+	bigUintType, err := abi.ParseType(contractABI, "BigUint")
+	require.Nil(t, err)
+	require.Equal(t, "*big.Int", goTypeForOut(bigUintType))
+}
+
+func TestBind_GeneratesFormattedSource(t *testing.T) {
+	t.Parallel()
+
+	contractABI := testContractABI()
+
+	source, err := Bind(contractABI, "adder", "", []byte(`{"name":"adder"}`))
+	require.Nil(t, err)
+
+	generated := string(source)
+	require.True(t, strings.Contains(generated, "package adder"))
+	require.True(t, strings.Contains(generated, "type Adder struct"))
+	require.True(t, strings.Contains(generated, "func (c *AdderCaller) GetSum(ctx context.Context) (*big.Int, error)"))
+	require.True(t, strings.Contains(generated, "func (t *AdderTransactor) Add(ctx context.Context"))
+	require.True(t, strings.Contains(generated, "type AddEventEvent struct"))
+	require.True(t, strings.Contains(generated, "type Reward struct"))
+}
+
+func TestUsesBigInt_DetectsListOfBigInt(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := abi.NewABIFromJSON([]byte(`{
+		"name": "summer",
+		"endpoints": [
+			{"name": "sumAll", "mutability": "mutable", "inputs": [{"name": "values", "type": "List<BigUint>"}], "outputs": []}
+		]
+	}`))
+	require.Nil(t, err)
+
+	source, err := Bind(contractABI, "summer", "", []byte(`{"name":"summer"}`))
+	require.Nil(t, err)
+
+	generated := string(source)
+	require.True(t, strings.Contains(generated, "values []*big.Int"))
+	require.True(t, strings.Contains(generated, `"math/big"`))
+}
+
+func TestBind_RequiresResolvableTypeName(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := abi.NewABIFromJSON([]byte(`{"name": "", "endpoints": []}`))
+	require.Nil(t, err)
+
+	_, err = Bind(contractABI, "main", "", []byte(`{}`))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,52 @@
+// Command erdbind generates a Go contract binding from a MultiversX smart contract ABI json
+// file, mirroring the role go-ethereum's abigen plays for Solidity contracts.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/abi"
+)
+
+func main() {
+	abiPath := flag.String("abi", "", "path to the contract's *.abi.json file (required)")
+	pkgName := flag.String("pkg", "main", "package name of the generated Go file")
+	typeName := flag.String("type", "", "Go type name for the contract binding (defaults to the ABI's \"name\" field)")
+	outPath := flag.String("out", "", "output file path (defaults to stdout)")
+	flag.Parse()
+
+	if err := run(*abiPath, *pkgName, *typeName, *outPath); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, "erdbind:", err)
+		os.Exit(1)
+	}
+}
+
+func run(abiPath, pkgName, typeName, outPath string) error {
+	if abiPath == "" {
+		return fmt.Errorf("-abi is required")
+	}
+
+	rawABI, err := os.ReadFile(abiPath)
+	if err != nil {
+		return fmt.Errorf("%w while reading %s", err, abiPath)
+	}
+
+	contractABI, err := abi.NewABIFromJSON(rawABI)
+	if err != nil {
+		return err
+	}
+
+	source, err := Bind(contractABI, pkgName, typeName, rawABI)
+	if err != nil {
+		return err
+	}
+
+	if outPath == "" {
+		_, err = os.Stdout.Write(source)
+		return err
+	}
+
+	return os.WriteFile(outPath, source, 0644)
+}
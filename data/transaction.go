@@ -75,6 +75,7 @@ type TransactionOnNetwork struct {
 	HyperblockHash   string                                `json:"hyperblockHash"`
 	Receipt          *transaction.ReceiptApi               `json:"receipt,omitempty"`
 	ScResults        []*transaction.ApiSmartContractResult `json:"smartContractResults,omitempty"`
+	Logs             *transaction.ApiLogs                  `json:"logs,omitempty"`
 }
 
 // TxCostResponseData follows the format of the data field of a transaction cost request
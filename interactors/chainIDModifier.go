@@ -0,0 +1,68 @@
+package interactors
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/blockchain"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// ChainIDModifier sets a transaction's ChainID and Version from the network's config, fetched
+// once via Proxy.GetNetworkConfig and cached for the lifetime of the modifier
+type ChainIDModifier struct {
+	proxy blockchain.Proxy
+
+	mutConfig sync.Mutex
+	chainID   string
+	version   uint32
+}
+
+// NewChainIDModifier creates a ChainIDModifier that will query proxy for the network config the
+// first time it is asked to modify a transaction
+func NewChainIDModifier(proxy blockchain.Proxy) (*ChainIDModifier, error) {
+	if check.IfNil(proxy) {
+		return nil, ErrNilProxy
+	}
+
+	return &ChainIDModifier{proxy: proxy}, nil
+}
+
+// Modify sets tx.ChainID and tx.Version from the cached network config, fetching it first if this
+// is the first call
+func (modifier *ChainIDModifier) Modify(ctx context.Context, tx *data.Transaction) error {
+	chainID, version, err := modifier.cachedNetworkConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx.ChainID = chainID
+	tx.Version = version
+
+	return nil
+}
+
+func (modifier *ChainIDModifier) cachedNetworkConfig(ctx context.Context) (string, uint32, error) {
+	modifier.mutConfig.Lock()
+	defer modifier.mutConfig.Unlock()
+
+	if modifier.chainID != "" {
+		return modifier.chainID, modifier.version, nil
+	}
+
+	networkConfig, err := modifier.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	modifier.chainID = networkConfig.ChainID
+	modifier.version = networkConfig.MinTransactionVersion
+
+	return modifier.chainID, modifier.version, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (modifier *ChainIDModifier) IsInterfaceNil() bool {
+	return modifier == nil
+}
@@ -0,0 +1,43 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// DataBuilderModifier sets a transaction's Data field from a pre-configured builder - either a
+// builders.TxDataBuilder or a builders.ABITxDataBuilder - letting a TxPipeline build the
+// @-separated call data as one more stage instead of requiring callers to set tx.Data themselves
+// before handing the transaction to the pipeline
+type DataBuilderModifier struct {
+	builder dataBuilder
+}
+
+// NewDataBuilderModifier creates a DataBuilderModifier wrapping builder, which must already have
+// its function and arguments set
+func NewDataBuilderModifier(builder dataBuilder) (*DataBuilderModifier, error) {
+	if check.IfNil(builder) {
+		return nil, ErrNilDataBuilder
+	}
+
+	return &DataBuilderModifier{builder: builder}, nil
+}
+
+// Modify sets tx.Data to the wrapped builder's encoded data field
+func (modifier *DataBuilderModifier) Modify(_ context.Context, tx *data.Transaction) error {
+	dataBytes, err := modifier.builder.ToDataBytes()
+	if err != nil {
+		return err
+	}
+
+	tx.Data = dataBytes
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (modifier *DataBuilderModifier) IsInterfaceNil() bool {
+	return modifier == nil
+}
@@ -0,0 +1,34 @@
+package interactors
+
+import "errors"
+
+// ErrNilProxy signals that a nil Proxy has been provided
+var ErrNilProxy = errors.New("nil proxy")
+
+// ErrNilModifier signals that a nil TxModifier has been provided to a TxPipeline
+var ErrNilModifier = errors.New("nil tx modifier")
+
+// ErrNilPipeline signals that a nil TxPipeline has been provided to a TransactionInteractor
+var ErrNilPipeline = errors.New("nil tx pipeline")
+
+// ErrNilTransaction signals that a nil transaction has been provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrNilSigner signals that a nil TxSigner has been provided to a SignerModifier
+var ErrNilSigner = errors.New("nil tx signer")
+
+// ErrNilPrivateKey signals that no private key bytes have been provided to a SignerModifier
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilDataBuilder signals that a nil builder has been provided to a DataBuilderModifier
+var ErrNilDataBuilder = errors.New("nil tx data builder")
+
+// ErrInvalidSafetyFactor signals that a GasLimitModifier was given a safety factor below 1
+var ErrInvalidSafetyFactor = errors.New("gas limit safety factor must be >= 1")
+
+// ErrInvalidMultiplier signals that a GasPriceModifier was given a multiplier below 1
+var ErrInvalidMultiplier = errors.New("gas price multiplier must be >= 1")
+
+// ErrInvalidModifierIndex signals that a TxPipeline.Insert or TxPipeline.Replace call was given
+// an out-of-range stage index
+var ErrInvalidModifierIndex = errors.New("modifier index out of range")
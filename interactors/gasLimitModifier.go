@@ -0,0 +1,49 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/blockchain"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// GasLimitModifier sets a transaction's GasLimit to the network's cost estimate for it (obtained
+// via Proxy.RequestTransactionCost), scaled by safetyFactor to leave headroom for the estimate
+// drifting between RequestTransactionCost and the transaction actually executing
+type GasLimitModifier struct {
+	proxy        blockchain.Proxy
+	safetyFactor float64
+}
+
+// NewGasLimitModifier creates a GasLimitModifier. safetyFactor must be at least 1.
+func NewGasLimitModifier(proxy blockchain.Proxy, safetyFactor float64) (*GasLimitModifier, error) {
+	if check.IfNil(proxy) {
+		return nil, ErrNilProxy
+	}
+	if safetyFactor < 1 {
+		return nil, ErrInvalidSafetyFactor
+	}
+
+	return &GasLimitModifier{
+		proxy:        proxy,
+		safetyFactor: safetyFactor,
+	}, nil
+}
+
+// Modify sets tx.GasLimit from the network's cost estimate for tx, times the configured safety factor
+func (modifier *GasLimitModifier) Modify(ctx context.Context, tx *data.Transaction) error {
+	cost, err := modifier.proxy.RequestTransactionCost(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	tx.GasLimit = uint64(float64(cost.TxCost) * modifier.safetyFactor)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (modifier *GasLimitModifier) IsInterfaceNil() bool {
+	return modifier == nil
+}
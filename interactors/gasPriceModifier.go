@@ -0,0 +1,73 @@
+package interactors
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/blockchain"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// GasPriceModifier sets a transaction's GasPrice to the network's minimum gas price, fetched once
+// via Proxy.GetNetworkConfig and cached, times a configurable multiplier
+type GasPriceModifier struct {
+	proxy      blockchain.Proxy
+	multiplier float64
+
+	mutMinGasPrice  sync.Mutex
+	minGasPrice     uint64
+	haveMinGasPrice bool
+}
+
+// NewGasPriceModifier creates a GasPriceModifier. multiplier must be at least 1.
+func NewGasPriceModifier(proxy blockchain.Proxy, multiplier float64) (*GasPriceModifier, error) {
+	if check.IfNil(proxy) {
+		return nil, ErrNilProxy
+	}
+	if multiplier < 1 {
+		return nil, ErrInvalidMultiplier
+	}
+
+	return &GasPriceModifier{
+		proxy:      proxy,
+		multiplier: multiplier,
+	}, nil
+}
+
+// Modify sets tx.GasPrice from the cached network minimum gas price, fetching it first if this is
+// the first call
+func (modifier *GasPriceModifier) Modify(ctx context.Context, tx *data.Transaction) error {
+	minGasPrice, err := modifier.cachedMinGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx.GasPrice = uint64(float64(minGasPrice) * modifier.multiplier)
+
+	return nil
+}
+
+func (modifier *GasPriceModifier) cachedMinGasPrice(ctx context.Context) (uint64, error) {
+	modifier.mutMinGasPrice.Lock()
+	defer modifier.mutMinGasPrice.Unlock()
+
+	if modifier.haveMinGasPrice {
+		return modifier.minGasPrice, nil
+	}
+
+	networkConfig, err := modifier.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	modifier.minGasPrice = networkConfig.MinGasPrice
+	modifier.haveMinGasPrice = true
+
+	return modifier.minGasPrice, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (modifier *GasPriceModifier) IsInterfaceNil() bool {
+	return modifier == nil
+}
@@ -0,0 +1,32 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// TxModifier mutates a single field (or related group of fields) on a transaction before it is
+// signed and sent - a nonce, a gas limit/price, a chain ID, the data field. TxPipeline applies an
+// ordered list of them, so a caller assembles the sequence it needs instead of reimplementing it
+// at every call site.
+type TxModifier interface {
+	Modify(ctx context.Context, tx *data.Transaction) error
+	IsInterfaceNil() bool
+}
+
+// TxSigner produces the signature to attach to a transaction's Signature field
+type TxSigner interface {
+	SignTransaction(tx *data.Transaction, privateKeyBytes []byte) (string, error)
+	IsInterfaceNil() bool
+}
+
+// dataBuilder is the subset of builders.TxDataBuilder and builders.ABITxDataBuilder that
+// DataBuilderModifier needs: rendering an already-configured call (function and arguments set)
+// into the bytes a transaction's Data field expects. Kept narrow instead of depending on either
+// concrete builder's full chaining interface, since builders.ABITxDataBuilder's chaining methods
+// return *ABITxDataBuilder rather than builders.TxDataBuilder.
+type dataBuilder interface {
+	ToDataBytes() ([]byte, error)
+	IsInterfaceNil() bool
+}
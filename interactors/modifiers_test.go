@@ -0,0 +1,245 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceModifier_Modify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("network mode fetches the account nonce on every call", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		proxy := &proxyStub{
+			getAccountCalled: func(_ context.Context, _ core.AddressHandler) (*data.Account, error) {
+				calls++
+				return &data.Account{Nonce: 5}, nil
+			},
+		}
+
+		modifier, err := NewNonceModifier(proxy, false)
+		require.Nil(t, err)
+
+		tx := &data.Transaction{SndAddr: testSenderBech32}
+		require.Nil(t, modifier.Modify(context.Background(), tx))
+		require.Equal(t, uint64(5), tx.Nonce)
+
+		require.Nil(t, modifier.Modify(context.Background(), tx))
+		require.Equal(t, uint64(5), tx.Nonce)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("pending mode fetches once then increments locally", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		proxy := &proxyStub{
+			getAccountCalled: func(_ context.Context, _ core.AddressHandler) (*data.Account, error) {
+				calls++
+				return &data.Account{Nonce: 5}, nil
+			},
+		}
+
+		modifier, err := NewNonceModifier(proxy, true)
+		require.Nil(t, err)
+
+		tx := &data.Transaction{SndAddr: testSenderBech32}
+		require.Nil(t, modifier.Modify(context.Background(), tx))
+		require.Equal(t, uint64(5), tx.Nonce)
+
+		require.Nil(t, modifier.Modify(context.Background(), tx))
+		require.Equal(t, uint64(6), tx.Nonce)
+
+		require.Nil(t, modifier.Modify(context.Background(), tx))
+		require.Equal(t, uint64(7), tx.Nonce)
+
+		require.Equal(t, 1, calls)
+	})
+}
+
+func TestChainIDModifier_Modify(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	proxy := &proxyStub{
+		getNetworkConfigCalled: func(_ context.Context) (*data.NetworkConfig, error) {
+			calls++
+			return &data.NetworkConfig{ChainID: "T", MinTransactionVersion: 1}, nil
+		},
+	}
+
+	modifier, err := NewChainIDModifier(proxy)
+	require.Nil(t, err)
+
+	tx := &data.Transaction{}
+	require.Nil(t, modifier.Modify(context.Background(), tx))
+	require.Equal(t, "T", tx.ChainID)
+	require.Equal(t, uint32(1), tx.Version)
+
+	require.Nil(t, modifier.Modify(context.Background(), tx))
+	require.Equal(t, 1, calls, "the network config should only be fetched once")
+}
+
+func TestGasLimitModifier_Modify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid safety factor should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewGasLimitModifier(&proxyStub{}, 0.5)
+		require.Equal(t, ErrInvalidSafetyFactor, err)
+	})
+
+	t.Run("scales the estimated cost by the safety factor", func(t *testing.T) {
+		t.Parallel()
+
+		proxy := &proxyStub{
+			requestTransactionCostCalled: func(_ context.Context, _ *data.Transaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{TxCost: 1000}, nil
+			},
+		}
+
+		modifier, err := NewGasLimitModifier(proxy, 1.5)
+		require.Nil(t, err)
+
+		tx := &data.Transaction{}
+		require.Nil(t, modifier.Modify(context.Background(), tx))
+		require.Equal(t, uint64(1500), tx.GasLimit)
+	})
+}
+
+func TestGasPriceModifier_Modify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid multiplier should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewGasPriceModifier(&proxyStub{}, 0.5)
+		require.Equal(t, ErrInvalidMultiplier, err)
+	})
+
+	t.Run("caches the minimum gas price and applies the multiplier", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		proxy := &proxyStub{
+			getNetworkConfigCalled: func(_ context.Context) (*data.NetworkConfig, error) {
+				calls++
+				return &data.NetworkConfig{MinGasPrice: 1000000000}, nil
+			},
+		}
+
+		modifier, err := NewGasPriceModifier(proxy, 2)
+		require.Nil(t, err)
+
+		tx := &data.Transaction{}
+		require.Nil(t, modifier.Modify(context.Background(), tx))
+		require.Equal(t, uint64(2000000000), tx.GasPrice)
+
+		require.Nil(t, modifier.Modify(context.Background(), tx))
+		require.Equal(t, 1, calls)
+	})
+}
+
+type signerStub struct {
+	signTransactionCalled func(tx *data.Transaction, privateKeyBytes []byte) (string, error)
+}
+
+func (s *signerStub) SignTransaction(tx *data.Transaction, privateKeyBytes []byte) (string, error) {
+	return s.signTransactionCalled(tx, privateKeyBytes)
+}
+
+func (s *signerStub) IsInterfaceNil() bool { return s == nil }
+
+func TestNewSignerModifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewSignerModifier(nil, []byte("key"))
+		require.Equal(t, ErrNilSigner, err)
+	})
+
+	t.Run("nil private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewSignerModifier(&signerStub{}, nil)
+		require.Equal(t, ErrNilPrivateKey, err)
+	})
+}
+
+func TestSignerModifier_Modify(t *testing.T) {
+	t.Parallel()
+
+	signer := &signerStub{
+		signTransactionCalled: func(tx *data.Transaction, privateKeyBytes []byte) (string, error) {
+			return "sig-" + string(privateKeyBytes), nil
+		},
+	}
+
+	modifier, err := NewSignerModifier(signer, []byte("key"))
+	require.Nil(t, err)
+
+	tx := &data.Transaction{}
+	require.Nil(t, modifier.Modify(context.Background(), tx))
+	require.Equal(t, "sig-key", tx.Signature)
+}
+
+type dataBuilderStub struct {
+	toDataBytesCalled func() ([]byte, error)
+}
+
+func (b *dataBuilderStub) ToDataBytes() ([]byte, error) {
+	return b.toDataBytesCalled()
+}
+
+func (b *dataBuilderStub) IsInterfaceNil() bool { return b == nil }
+
+func TestNewDataBuilderModifier(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewDataBuilderModifier(nil)
+	require.Equal(t, ErrNilDataBuilder, err)
+}
+
+func TestDataBuilderModifier_Modify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sets tx.Data from the wrapped builder", func(t *testing.T) {
+		t.Parallel()
+
+		builder := &dataBuilderStub{
+			toDataBytesCalled: func() ([]byte, error) { return []byte("transfer@64"), nil },
+		}
+
+		modifier, err := NewDataBuilderModifier(builder)
+		require.Nil(t, err)
+
+		tx := &data.Transaction{}
+		require.Nil(t, modifier.Modify(context.Background(), tx))
+		require.Equal(t, []byte("transfer@64"), tx.Data)
+	})
+
+	t.Run("propagates the builder's error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		builder := &dataBuilderStub{
+			toDataBytesCalled: func() ([]byte, error) { return nil, expectedErr },
+		}
+
+		modifier, err := NewDataBuilderModifier(builder)
+		require.Nil(t, err)
+
+		err = modifier.Modify(context.Background(), &data.Transaction{})
+		require.Equal(t, expectedErr, err)
+	})
+}
@@ -0,0 +1,72 @@
+package interactors
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/blockchain"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// NonceModifier sets a transaction's Nonce from the sender's account on the network. In pending
+// mode it fetches the account nonce only once per sender and then increments a local counter on
+// every subsequent call, so a caller can fire off a burst of transactions without waiting for each
+// one to be processed before building the next.
+type NonceModifier struct {
+	proxy   blockchain.Proxy
+	pending bool
+
+	mutNonces     sync.Mutex
+	pendingNonces map[string]uint64
+}
+
+// NewNonceModifier creates a NonceModifier. When pending is false, every call to Modify fetches
+// the sender's current nonce from the network; when true, only the first call per sender does.
+func NewNonceModifier(proxy blockchain.Proxy, pending bool) (*NonceModifier, error) {
+	if check.IfNil(proxy) {
+		return nil, ErrNilProxy
+	}
+
+	return &NonceModifier{
+		proxy:         proxy,
+		pending:       pending,
+		pendingNonces: make(map[string]uint64),
+	}, nil
+}
+
+// Modify sets tx.Nonce to the sender's next usable nonce
+func (modifier *NonceModifier) Modify(ctx context.Context, tx *data.Transaction) error {
+	modifier.mutNonces.Lock()
+	defer modifier.mutNonces.Unlock()
+
+	if modifier.pending {
+		if nonce, ok := modifier.pendingNonces[tx.SndAddr]; ok {
+			tx.Nonce = nonce
+			modifier.pendingNonces[tx.SndAddr] = nonce + 1
+			return nil
+		}
+	}
+
+	address, err := data.NewAddressFromBech32String(tx.SndAddr)
+	if err != nil {
+		return err
+	}
+
+	account, err := modifier.proxy.GetAccount(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	tx.Nonce = account.Nonce
+	if modifier.pending {
+		modifier.pendingNonces[tx.SndAddr] = account.Nonce + 1
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (modifier *NonceModifier) IsInterfaceNil() bool {
+	return modifier == nil
+}
@@ -0,0 +1,82 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// proxyStub is a configurable blockchain.Proxy test double: each modifier test only wires up the
+// method(s) it exercises, leaving the rest to panic if unexpectedly called
+type proxyStub struct {
+	getNetworkConfigCalled       func(ctx context.Context) (*data.NetworkConfig, error)
+	getAccountCalled             func(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	requestTransactionCostCalled func(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
+	sendTransactionCalled        func(ctx context.Context, tx *data.Transaction) (string, error)
+}
+
+func (p *proxyStub) GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error) {
+	return p.getNetworkConfigCalled(ctx)
+}
+
+func (p *proxyStub) GetNetworkStatus(_ context.Context, _ uint32) (*data.NetworkStatus, error) {
+	return &data.NetworkStatus{}, nil
+}
+
+func (p *proxyStub) GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+	return p.getAccountCalled(ctx, address)
+}
+
+func (p *proxyStub) GetStorageValue(_ context.Context, _ core.AddressHandler, _ []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (p *proxyStub) ExecuteVMQuery(_ context.Context, _ *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+	return &data.VmValuesResponseData{}, nil
+}
+
+func (p *proxyStub) SendTransaction(ctx context.Context, tx *data.Transaction) (string, error) {
+	return p.sendTransactionCalled(ctx, tx)
+}
+
+func (p *proxyStub) SendTransactions(_ context.Context, _ []*data.Transaction) ([]string, error) {
+	return nil, nil
+}
+
+func (p *proxyStub) GetTransactionStatus(_ context.Context, _ string) (string, error) {
+	return "", nil
+}
+
+func (p *proxyStub) GetTransactionInfoWithResults(_ context.Context, _ string) (*data.TransactionInfo, error) {
+	return &data.TransactionInfo{}, nil
+}
+
+func (p *proxyStub) RequestTransactionCost(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
+	return p.requestTransactionCostCalled(ctx, tx)
+}
+
+func (p *proxyStub) GetLatestHyperBlockNonce(_ context.Context) (uint64, error) {
+	return 0, nil
+}
+
+func (p *proxyStub) GetHyperBlockByNonce(_ context.Context, _ uint64) (*data.HyperBlock, error) {
+	return &data.HyperBlock{}, nil
+}
+
+func (p *proxyStub) GetHyperBlockByHash(_ context.Context, _ string) (*data.HyperBlock, error) {
+	return &data.HyperBlock{}, nil
+}
+
+func (p *proxyStub) GetRawStartOfEpochMetaBlock(_ context.Context, _ uint32) (*data.RawBlockRespone, error) {
+	return &data.RawBlockRespone{}, nil
+}
+
+func (p *proxyStub) IsInterfaceNil() bool {
+	return p == nil
+}
+
+// testSenderBech32 is a well-known MultiversX test wallet (user account) address, used throughout
+// these tests anywhere a syntactically valid bech32 address is needed but its value is otherwise
+// irrelevant
+const testSenderBech32 = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
@@ -0,0 +1,47 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// SignerModifier is meant to be the last stage in a TxPipeline: it signs the transaction with the
+// wrapped TxSigner once every earlier stage has filled in the fields the signature covers
+type SignerModifier struct {
+	signer          TxSigner
+	privateKeyBytes []byte
+}
+
+// NewSignerModifier creates a SignerModifier that signs with privateKeyBytes through signer
+func NewSignerModifier(signer TxSigner, privateKeyBytes []byte) (*SignerModifier, error) {
+	if check.IfNil(signer) {
+		return nil, ErrNilSigner
+	}
+	if len(privateKeyBytes) == 0 {
+		return nil, ErrNilPrivateKey
+	}
+
+	return &SignerModifier{
+		signer:          signer,
+		privateKeyBytes: privateKeyBytes,
+	}, nil
+}
+
+// Modify sets tx.Signature to the wrapped signer's signature over tx
+func (modifier *SignerModifier) Modify(_ context.Context, tx *data.Transaction) error {
+	signature, err := modifier.signer.SignTransaction(tx, modifier.privateKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	tx.Signature = signature
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (modifier *SignerModifier) IsInterfaceNil() bool {
+	return modifier == nil
+}
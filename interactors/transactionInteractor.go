@@ -0,0 +1,109 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/blockchain"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// TransactionInteractor wraps a Proxy and a TxPipeline, so callers can build a ready-to-send
+// transaction by composing modifier stages instead of separately querying network config,
+// estimating gas, bumping the nonce and signing at every call site. It comes with a sensible
+// default pipeline (chain ID, nonce, gas limit, gas price, then signature) but callers are free to
+// insert or replace stages - e.g. a relayer inserting a guardian-signature modifier ahead of the
+// final SignerModifier.
+type TransactionInteractor struct {
+	proxy    blockchain.Proxy
+	pipeline *TxPipeline
+}
+
+// NewTransactionInteractor creates a TransactionInteractor with the default pipeline: a
+// ChainIDModifier, a network-mode NonceModifier, a GasLimitModifier using gasSafetyFactor, a
+// GasPriceModifier using gasPriceMultiplier, and finally a SignerModifier signing with signer and
+// privateKeyBytes
+func NewTransactionInteractor(
+	proxy blockchain.Proxy,
+	signer TxSigner,
+	privateKeyBytes []byte,
+	gasSafetyFactor float64,
+	gasPriceMultiplier float64,
+) (*TransactionInteractor, error) {
+	if check.IfNil(proxy) {
+		return nil, ErrNilProxy
+	}
+
+	chainIDModifier, err := NewChainIDModifier(proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceModifier, err := NewNonceModifier(proxy, false)
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimitModifier, err := NewGasLimitModifier(proxy, gasSafetyFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPriceModifier, err := NewGasPriceModifier(proxy, gasPriceMultiplier)
+	if err != nil {
+		return nil, err
+	}
+
+	signerModifier, err := NewSignerModifier(signer, privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline, err := NewTxPipeline(chainIDModifier, nonceModifier, gasLimitModifier, gasPriceModifier, signerModifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransactionInteractor{
+		proxy:    proxy,
+		pipeline: pipeline,
+	}, nil
+}
+
+// SetPipeline replaces the interactor's whole modifier pipeline, for callers who want full control
+// over stage order instead of building on the default one
+func (ti *TransactionInteractor) SetPipeline(pipeline *TxPipeline) error {
+	if check.IfNil(pipeline) {
+		return ErrNilPipeline
+	}
+
+	ti.pipeline = pipeline
+
+	return nil
+}
+
+// InsertModifier inserts modifier at position index in the interactor's pipeline
+func (ti *TransactionInteractor) InsertModifier(index int, modifier TxModifier) error {
+	return ti.pipeline.Insert(index, modifier)
+}
+
+// ReplaceModifier swaps the stage at position index in the interactor's pipeline for modifier
+func (ti *TransactionInteractor) ReplaceModifier(index int, modifier TxModifier) error {
+	return ti.pipeline.Replace(index, modifier)
+}
+
+// ApplyAndSend runs the interactor's pipeline against tx and, if every stage succeeds, broadcasts
+// it through the wrapped Proxy
+func (ti *TransactionInteractor) ApplyAndSend(ctx context.Context, tx *data.Transaction) (string, error) {
+	err := ti.pipeline.Modify(ctx, tx)
+	if err != nil {
+		return "", err
+	}
+
+	return ti.proxy.SendTransaction(ctx, tx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ti *TransactionInteractor) IsInterfaceNil() bool {
+	return ti == nil
+}
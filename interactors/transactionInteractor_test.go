@@ -0,0 +1,115 @@
+package interactors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/core"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransactionInteractor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewTransactionInteractor(nil, &signerStub{}, []byte("key"), 1, 1)
+		require.Equal(t, ErrNilProxy, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ti, err := NewTransactionInteractor(&proxyStub{}, &signerStub{}, []byte("key"), 1, 1)
+		require.Nil(t, err)
+		require.NotNil(t, ti)
+	})
+}
+
+func TestTransactionInteractor_ApplyAndSend(t *testing.T) {
+	t.Parallel()
+
+	var sentTx *data.Transaction
+	proxy := &proxyStub{
+		getNetworkConfigCalled: func(_ context.Context) (*data.NetworkConfig, error) {
+			return &data.NetworkConfig{ChainID: "T", MinTransactionVersion: 1}, nil
+		},
+		getAccountCalled: func(_ context.Context, _ core.AddressHandler) (*data.Account, error) {
+			return &data.Account{Nonce: 7}, nil
+		},
+		requestTransactionCostCalled: func(_ context.Context, _ *data.Transaction) (*data.TxCostResponseData, error) {
+			return &data.TxCostResponseData{TxCost: 1000}, nil
+		},
+		sendTransactionCalled: func(_ context.Context, tx *data.Transaction) (string, error) {
+			sentTx = tx
+			return "txHash", nil
+		},
+	}
+	signer := &signerStub{
+		signTransactionCalled: func(_ *data.Transaction, _ []byte) (string, error) {
+			return "signature", nil
+		},
+	}
+
+	ti, err := NewTransactionInteractor(proxy, signer, []byte("key"), 1.5, 2)
+	require.Nil(t, err)
+
+	tx := &data.Transaction{SndAddr: testSenderBech32}
+	hash, err := ti.ApplyAndSend(context.Background(), tx)
+	require.Nil(t, err)
+	require.Equal(t, "txHash", hash)
+
+	require.Equal(t, "T", tx.ChainID)
+	require.Equal(t, uint32(1), tx.Version)
+	require.Equal(t, uint64(7), tx.Nonce)
+	require.Equal(t, uint64(1500), tx.GasLimit)
+	require.Equal(t, "signature", tx.Signature)
+	require.True(t, tx == sentTx)
+}
+
+func TestTransactionInteractor_InsertAndReplaceModifier(t *testing.T) {
+	t.Parallel()
+
+	proxy := &proxyStub{
+		getNetworkConfigCalled: func(_ context.Context) (*data.NetworkConfig, error) {
+			return &data.NetworkConfig{}, nil
+		},
+		getAccountCalled: func(_ context.Context, _ core.AddressHandler) (*data.Account, error) {
+			return &data.Account{}, nil
+		},
+		requestTransactionCostCalled: func(_ context.Context, _ *data.Transaction) (*data.TxCostResponseData, error) {
+			return &data.TxCostResponseData{}, nil
+		},
+	}
+	signer := &signerStub{
+		signTransactionCalled: func(_ *data.Transaction, _ []byte) (string, error) { return "signature", nil },
+	}
+
+	ti, err := NewTransactionInteractor(proxy, signer, []byte("key"), 1, 1)
+	require.Nil(t, err)
+
+	err = ti.InsertModifier(0, nil)
+	require.Equal(t, ErrNilModifier, err)
+
+	err = ti.ReplaceModifier(0, nil)
+	require.Equal(t, ErrNilModifier, err)
+
+	var replaced bool
+	err = ti.ReplaceModifier(0, &modifierStub{modifyCalled: func(_ context.Context, _ *data.Transaction) error {
+		replaced = true
+		return nil
+	}})
+	require.Nil(t, err)
+
+	err = ti.SetPipeline(nil)
+	require.Equal(t, ErrNilPipeline, err)
+
+	err = ti.SetPipeline(ti.pipeline)
+	require.Nil(t, err)
+
+	err = ti.pipeline.Modify(context.Background(), &data.Transaction{SndAddr: testSenderBech32})
+	require.Nil(t, err)
+	require.True(t, replaced)
+}
@@ -0,0 +1,81 @@
+package interactors
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// TxPipeline applies an ordered list of TxModifiers to a transaction, mirroring the modifier
+// pattern used by defiweb/go-eth's txmodifier package: each stage only fills in the field(s) it
+// owns, so a caller composes the sequence it needs (and can insert or replace a stage, e.g. a
+// relayer injecting a guardian-signature modifier ahead of the final signer) instead of hand-
+// rolling the whole nonce/gas/chainID/signature sequence at every call site.
+type TxPipeline struct {
+	modifiers []TxModifier
+}
+
+// NewTxPipeline creates a pipeline that applies modifiers, in order, to every transaction passed
+// to Modify
+func NewTxPipeline(modifiers ...TxModifier) (*TxPipeline, error) {
+	for _, modifier := range modifiers {
+		if check.IfNil(modifier) {
+			return nil, ErrNilModifier
+		}
+	}
+
+	return &TxPipeline{modifiers: modifiers}, nil
+}
+
+// Modify runs every stage against tx, in order, stopping at the first error
+func (pipeline *TxPipeline) Modify(ctx context.Context, tx *data.Transaction) error {
+	if tx == nil {
+		return ErrNilTransaction
+	}
+
+	for _, modifier := range pipeline.modifiers {
+		err := modifier.Modify(ctx, tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Insert adds modifier at position index among the pipeline's existing stages, shifting the
+// stages from index onward one place later
+func (pipeline *TxPipeline) Insert(index int, modifier TxModifier) error {
+	if check.IfNil(modifier) {
+		return ErrNilModifier
+	}
+	if index < 0 || index > len(pipeline.modifiers) {
+		return ErrInvalidModifierIndex
+	}
+
+	pipeline.modifiers = append(pipeline.modifiers, nil)
+	copy(pipeline.modifiers[index+1:], pipeline.modifiers[index:])
+	pipeline.modifiers[index] = modifier
+
+	return nil
+}
+
+// Replace swaps the stage at position index for modifier
+func (pipeline *TxPipeline) Replace(index int, modifier TxModifier) error {
+	if check.IfNil(modifier) {
+		return ErrNilModifier
+	}
+	if index < 0 || index >= len(pipeline.modifiers) {
+		return ErrInvalidModifierIndex
+	}
+
+	pipeline.modifiers[index] = modifier
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pipeline *TxPipeline) IsInterfaceNil() bool {
+	return pipeline == nil
+}
@@ -0,0 +1,173 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/stretchr/testify/require"
+)
+
+type modifierStub struct {
+	modifyCalled func(ctx context.Context, tx *data.Transaction) error
+}
+
+func (m *modifierStub) Modify(ctx context.Context, tx *data.Transaction) error {
+	return m.modifyCalled(ctx, tx)
+}
+
+func (m *modifierStub) IsInterfaceNil() bool { return m == nil }
+
+func TestNewTxPipeline(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil modifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewTxPipeline(nil)
+		require.Equal(t, ErrNilModifier, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pipeline, err := NewTxPipeline(&modifierStub{})
+		require.Nil(t, err)
+		require.NotNil(t, pipeline)
+	})
+}
+
+func TestTxPipeline_Modify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		pipeline, err := NewTxPipeline()
+		require.Nil(t, err)
+
+		err = pipeline.Modify(context.Background(), nil)
+		require.Equal(t, ErrNilTransaction, err)
+	})
+
+	t.Run("runs stages in order, stopping at the first error", func(t *testing.T) {
+		t.Parallel()
+
+		var order []int
+		expectedErr := errors.New("expected error")
+
+		pipeline, err := NewTxPipeline(
+			&modifierStub{modifyCalled: func(_ context.Context, _ *data.Transaction) error {
+				order = append(order, 1)
+				return nil
+			}},
+			&modifierStub{modifyCalled: func(_ context.Context, _ *data.Transaction) error {
+				order = append(order, 2)
+				return expectedErr
+			}},
+			&modifierStub{modifyCalled: func(_ context.Context, _ *data.Transaction) error {
+				order = append(order, 3)
+				return nil
+			}},
+		)
+		require.Nil(t, err)
+
+		err = pipeline.Modify(context.Background(), &data.Transaction{})
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, []int{1, 2}, order)
+	})
+}
+
+func TestTxPipeline_Insert(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil modifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		pipeline, err := NewTxPipeline(&modifierStub{})
+		require.Nil(t, err)
+
+		err = pipeline.Insert(0, nil)
+		require.Equal(t, ErrNilModifier, err)
+	})
+
+	t.Run("out of range index should error", func(t *testing.T) {
+		t.Parallel()
+
+		pipeline, err := NewTxPipeline(&modifierStub{})
+		require.Nil(t, err)
+
+		err = pipeline.Insert(2, &modifierStub{})
+		require.Equal(t, ErrInvalidModifierIndex, err)
+	})
+
+	t.Run("inserts at the given position, shifting later stages", func(t *testing.T) {
+		t.Parallel()
+
+		var order []int
+		stage := func(n int) *modifierStub {
+			return &modifierStub{modifyCalled: func(_ context.Context, _ *data.Transaction) error {
+				order = append(order, n)
+				return nil
+			}}
+		}
+
+		pipeline, err := NewTxPipeline(stage(1), stage(3))
+		require.Nil(t, err)
+
+		err = pipeline.Insert(1, stage(2))
+		require.Nil(t, err)
+
+		err = pipeline.Modify(context.Background(), &data.Transaction{})
+		require.Nil(t, err)
+		require.Equal(t, []int{1, 2, 3}, order)
+	})
+}
+
+func TestTxPipeline_Replace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil modifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		pipeline, err := NewTxPipeline(&modifierStub{})
+		require.Nil(t, err)
+
+		err = pipeline.Replace(0, nil)
+		require.Equal(t, ErrNilModifier, err)
+	})
+
+	t.Run("out of range index should error", func(t *testing.T) {
+		t.Parallel()
+
+		pipeline, err := NewTxPipeline(&modifierStub{})
+		require.Nil(t, err)
+
+		err = pipeline.Replace(1, &modifierStub{})
+		require.Equal(t, ErrInvalidModifierIndex, err)
+	})
+
+	t.Run("swaps the stage at the given position", func(t *testing.T) {
+		t.Parallel()
+
+		called := false
+		replacement := &modifierStub{modifyCalled: func(_ context.Context, _ *data.Transaction) error {
+			called = true
+			return nil
+		}}
+
+		pipeline, err := NewTxPipeline(&modifierStub{modifyCalled: func(_ context.Context, _ *data.Transaction) error {
+			t.Fatal("original stage should have been replaced")
+			return nil
+		}})
+		require.Nil(t, err)
+
+		err = pipeline.Replace(0, replacement)
+		require.Nil(t, err)
+
+		err = pipeline.Modify(context.Background(), &data.Transaction{})
+		require.Nil(t, err)
+		require.True(t, called)
+	})
+}
@@ -0,0 +1,112 @@
+// Package scresults interprets a data.TransactionOnNetwork's smart contract results and event
+// logs against a contract ABI, closing the loop on the ABI/binding work in abi and bind: it gives
+// callers an ergonomic equivalent of Ethereum's receipt-log parsing instead of requiring them to
+// hand-split "@"-separated hex data themselves.
+package scresults
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/abi"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+const (
+	dataSeparator = "@"
+	okReturnCode  = "6f6b" // hex("ok")
+)
+
+// CallFailedError is returned by DecodeCallResult when the top-level call's return code signals
+// failure. ReturnCode and ReturnMessage hold the decoded (not hex-encoded) values from the result.
+type CallFailedError struct {
+	ReturnCode    string
+	ReturnMessage string
+}
+
+// Error implements the error interface
+func (e *CallFailedError) Error() string {
+	return fmt.Sprintf("call failed with return code %q: %s", e.ReturnCode, e.ReturnMessage)
+}
+
+// DecodeCallResult locates, among tx's smart contract results, the one carrying the top-level
+// call's return data - identified by a Data field starting with "@" - and decodes its outcome:
+//   - if the return code is "ok", its remaining "@"-separated hex chunks are decoded against
+//     endpointName's declared outputs and written into out, the same way abi.Decode does for a
+//     VM query's return data;
+//   - otherwise a *CallFailedError is returned, carrying the decoded return code and message.
+func DecodeCallResult(contractABI *abi.ABI, endpointName string, tx *data.TransactionOnNetwork, out ...interface{}) error {
+	if tx == nil {
+		return ErrNilTransaction
+	}
+
+	scr, err := findCallResult(tx)
+	if err != nil {
+		return err
+	}
+
+	parts := strings.Split(strings.TrimPrefix(string(scr.Data), dataSeparator), dataSeparator)
+	if len(parts) == 0 || parts[0] == "" {
+		return ErrMissingReturnCode
+	}
+
+	if !strings.EqualFold(parts[0], okReturnCode) {
+		return newCallFailedError(parts[0], parts[1:])
+	}
+
+	returnData, err := hexDecodeAll(parts[1:])
+	if err != nil {
+		return err
+	}
+
+	return abi.Decode(contractABI, endpointName, returnData, out...)
+}
+
+func findCallResult(tx *data.TransactionOnNetwork) (*transaction.ApiSmartContractResult, error) {
+	for _, scr := range tx.ScResults {
+		if scr == nil || len(scr.Data) == 0 {
+			continue
+		}
+		if scr.Data[0] == '@' {
+			return scr, nil
+		}
+	}
+
+	return nil, ErrCallResultNotFound
+}
+
+func newCallFailedError(returnCodeHex string, messageParts []string) error {
+	decodedReturnCode, err := hex.DecodeString(returnCodeHex)
+	if err != nil {
+		return fmt.Errorf("%w: return code", ErrInvalidReturnData)
+	}
+
+	decodedParts := make([]string, 0, len(messageParts))
+	for _, part := range messageParts {
+		decoded, err := hex.DecodeString(part)
+		if err != nil {
+			return fmt.Errorf("%w: return message", ErrInvalidReturnData)
+		}
+		decodedParts = append(decodedParts, string(decoded))
+	}
+
+	return &CallFailedError{
+		ReturnCode:    string(decodedReturnCode),
+		ReturnMessage: strings.Join(decodedParts, dataSeparator),
+	}
+}
+
+func hexDecodeAll(hexParts []string) ([][]byte, error) {
+	decoded := make([][]byte, len(hexParts))
+	for i, part := range hexParts {
+		b, err := hex.DecodeString(part)
+		if err != nil {
+			return nil, fmt.Errorf("%w: chunk %d", ErrInvalidReturnData, i)
+		}
+		decoded[i] = b
+	}
+
+	return decoded, nil
+}
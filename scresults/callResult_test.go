@@ -0,0 +1,91 @@
+package scresults
+
+import (
+	"encoding/hex"
+	"testing"
+
+	goTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/abi"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/stretchr/testify/require"
+)
+
+const getValueABIJSON = `{
+	"name": "test",
+	"endpoints": [
+		{"name": "getValue", "inputs": [], "outputs": [{"type": "u64"}]}
+	]
+}`
+
+func testCallResultABI(t *testing.T) *abi.ABI {
+	contractABI, err := abi.NewABIFromJSON([]byte(getValueABIJSON))
+	require.Nil(t, err)
+	return contractABI
+}
+
+func TestDecodeCallResult(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		var out uint64
+		err := DecodeCallResult(testCallResultABI(t), "getValue", nil, &out)
+		require.Equal(t, ErrNilTransaction, err)
+	})
+
+	t.Run("no call result present should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.TransactionOnNetwork{ScResults: []*goTransaction.ApiSmartContractResult{
+			{Data: []byte("some unrelated result")},
+		}}
+
+		var out uint64
+		err := DecodeCallResult(testCallResultABI(t), "getValue", tx, &out)
+		require.Equal(t, ErrCallResultNotFound, err)
+	})
+
+	t.Run("decodes a successful call's return data", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.TransactionOnNetwork{ScResults: []*goTransaction.ApiSmartContractResult{
+			{Data: []byte("@" + okReturnCode + "@" + hex.EncodeToString([]byte{0, 0, 0, 0, 0, 0, 4, 0}))},
+		}}
+
+		var out uint64
+		err := DecodeCallResult(testCallResultABI(t), "getValue", tx, &out)
+		require.Nil(t, err)
+		require.Equal(t, uint64(1024), out)
+	})
+
+	t.Run("a failed call returns a CallFailedError with the decoded return code and message", func(t *testing.T) {
+		t.Parallel()
+
+		returnCodeHex := hex.EncodeToString([]byte("user error"))
+		messageHex := hex.EncodeToString([]byte("insufficient funds"))
+		tx := &data.TransactionOnNetwork{ScResults: []*goTransaction.ApiSmartContractResult{
+			{Data: []byte("@" + returnCodeHex + "@" + messageHex)},
+		}}
+
+		var out uint64
+		err := DecodeCallResult(testCallResultABI(t), "getValue", tx, &out)
+
+		callFailedErr, ok := err.(*CallFailedError)
+		require.True(t, ok)
+		require.Equal(t, "user error", callFailedErr.ReturnCode)
+		require.Equal(t, "insufficient funds", callFailedErr.ReturnMessage)
+	})
+
+	t.Run("non-hex return code should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.TransactionOnNetwork{ScResults: []*goTransaction.ApiSmartContractResult{
+			{Data: []byte("@zz")},
+		}}
+
+		var out uint64
+		err := DecodeCallResult(testCallResultABI(t), "getValue", tx, &out)
+		require.ErrorIs(t, err, ErrInvalidReturnData)
+	})
+}
@@ -0,0 +1,19 @@
+package scresults
+
+import "errors"
+
+// ErrNilTransaction signals that a nil TransactionOnNetwork has been provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrCallResultNotFound signals that none of a transaction's smart contract results carried a
+// "@"-prefixed return data field, so the top-level call's outcome could not be located
+var ErrCallResultNotFound = errors.New("top-level call result not found")
+
+// ErrMissingReturnCode signals that a call result's data field was empty after its "@" prefix
+var ErrMissingReturnCode = errors.New("call result is missing its return code")
+
+// ErrInvalidReturnData signals that a call result's "@"-separated data chunks were not valid hex
+var ErrInvalidReturnData = errors.New("invalid hex in call result data")
+
+// ErrMissingTopic signals that an event declares more indexed inputs than the log actually carries topics for
+var ErrMissingTopic = errors.New("event log is missing a declared indexed topic")
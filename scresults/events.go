@@ -0,0 +1,110 @@
+package scresults
+
+import (
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/abi"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+)
+
+// Event holds one decoded occurrence of an ABI-declared event. Fields holds one entry per input
+// the ABI declares for Identifier, keyed by input name, decoded the same way abi.DecodeTopLevel
+// decodes any other value - indexed inputs from their topic, the rest from the log's data payload.
+//
+// Callers that generated Go bindings for this ABI with cmd/erdbind get a typed Parse<Name>Event
+// function per event instead; Event/DecodeEvents exist for code that only has the ABI at hand,
+// e.g. generic tooling or logging that isn't compiled against a specific contract's bindings.
+type Event struct {
+	Identifier string
+	Fields     map[string]interface{}
+}
+
+// DecodeEvents scans tx's log and decodes every entry whose Identifier matches an event declared
+// in contractABI, returning one Event per match in log order. Log entries with no matching ABI
+// event (e.g. built-in ESDT transfer events) are skipped rather than treated as an error.
+func DecodeEvents(contractABI *abi.ABI, tx *data.TransactionOnNetwork) ([]Event, error) {
+	if contractABI == nil {
+		return nil, abi.ErrNilABI
+	}
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+	if tx.Logs == nil {
+		return nil, nil
+	}
+
+	events := make([]Event, 0, len(tx.Logs.Events))
+	for _, log := range tx.Logs.Events {
+		if log == nil {
+			continue
+		}
+
+		eventDef, err := contractABI.Event(log.Identifier)
+		if err != nil {
+			continue
+		}
+
+		decoded, err := decodeEvent(contractABI, eventDef, log.Topics, log.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, *decoded)
+	}
+
+	return events, nil
+}
+
+// decodeEvent decodes a single log entry's topics and data payload against eventDef's declared
+// inputs, matching indexed inputs against topics[1:] in declaration order (topics[0] is always
+// the event identifier itself) and the remaining inputs against the data payload as a tuple
+func decodeEvent(contractABI *abi.ABI, eventDef abi.Event, topics [][]byte, logData []byte) (*Event, error) {
+	fields := make(map[string]interface{}, len(eventDef.Inputs))
+
+	var nonIndexedTypes []abi.Type
+	var nonIndexedNames []string
+	topicIdx := 1
+
+	for _, input := range eventDef.Inputs {
+		fieldType, err := abi.ParseType(contractABI, input.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		if !input.Indexed {
+			nonIndexedTypes = append(nonIndexedTypes, fieldType)
+			nonIndexedNames = append(nonIndexedNames, input.Name)
+			continue
+		}
+
+		if topicIdx >= len(topics) {
+			return nil, ErrMissingTopic
+		}
+
+		value, err := abi.DecodeTopLevel(fieldType, topics[topicIdx])
+		if err != nil {
+			return nil, err
+		}
+
+		fields[input.Name] = value
+		topicIdx++
+	}
+
+	if len(nonIndexedTypes) > 0 {
+		tupleType := abi.Type{Kind: abi.KindTuple, TupleElems: nonIndexedTypes}
+
+		decoded, err := abi.DecodeTopLevel(tupleType, logData)
+		if err != nil {
+			return nil, err
+		}
+
+		values, ok := decoded.([]interface{})
+		if !ok {
+			return nil, abi.ErrInvalidEncodedValue
+		}
+
+		for i, name := range nonIndexedNames {
+			fields[name] = values[i]
+		}
+	}
+
+	return &Event{Identifier: eventDef.Identifier, Fields: fields}, nil
+}
@@ -0,0 +1,112 @@
+package scresults
+
+import (
+	"math/big"
+	"testing"
+
+	goTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/abi"
+	"github.com/ElrondNetwork/elrond-sdk-erdgo/data"
+	"github.com/stretchr/testify/require"
+)
+
+const transferEventABIJSON = `{
+	"name": "test",
+	"events": [
+		{
+			"identifier": "transfer",
+			"inputs": [
+				{"name": "amount", "type": "u32", "indexed": true},
+				{"name": "note", "type": "BigUint", "indexed": false}
+			]
+		}
+	]
+}`
+
+func testEventsABI(t *testing.T) *abi.ABI {
+	contractABI, err := abi.NewABIFromJSON([]byte(transferEventABIJSON))
+	require.Nil(t, err)
+	return contractABI
+}
+
+func encodeTopLevel(t *testing.T, contractABI *abi.ABI, typeStr string, value interface{}) []byte {
+	typ, err := abi.ParseType(contractABI, typeStr)
+	require.Nil(t, err)
+
+	encoded, err := abi.EncodeTopLevel(typ, value)
+	require.Nil(t, err)
+	return encoded
+}
+
+func TestDecodeEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil abi should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := DecodeEvents(nil, &data.TransactionOnNetwork{})
+		require.Equal(t, abi.ErrNilABI, err)
+	})
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := DecodeEvents(testEventsABI(t), nil)
+		require.Equal(t, ErrNilTransaction, err)
+	})
+
+	t.Run("no logs should return no events", func(t *testing.T) {
+		t.Parallel()
+
+		events, err := DecodeEvents(testEventsABI(t), &data.TransactionOnNetwork{})
+		require.Nil(t, err)
+		require.Nil(t, events)
+	})
+
+	t.Run("decodes indexed and non-indexed fields, skipping unknown events", func(t *testing.T) {
+		t.Parallel()
+
+		contractABI := testEventsABI(t)
+
+		amountTopic := encodeTopLevel(t, contractABI, "u32", uint32(7))
+		// non-indexed inputs are decoded from the data payload as a tuple, so each one is
+		// nested-encoded (a BigUint carries its length prefix)
+		noteType, err := abi.ParseType(contractABI, "BigUint")
+		require.Nil(t, err)
+		noteData, err := abi.EncodeNested(noteType, big.NewInt(42))
+		require.Nil(t, err)
+
+		tx := &data.TransactionOnNetwork{
+			Logs: &goTransaction.ApiLogs{
+				Events: []*goTransaction.Events{
+					{Identifier: "transfer", Topics: [][]byte{[]byte("transfer"), amountTopic}, Data: noteData},
+					{Identifier: "unrelatedEsdtEvent", Topics: [][]byte{[]byte("unrelatedEsdtEvent")}},
+				},
+			},
+		}
+
+		events, err := DecodeEvents(contractABI, tx)
+		require.Nil(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, "transfer", events[0].Identifier)
+		require.Equal(t, uint64(7), events[0].Fields["amount"])
+		require.Equal(t, big.NewInt(42), events[0].Fields["note"])
+	})
+
+	t.Run("missing indexed topic should error", func(t *testing.T) {
+		t.Parallel()
+
+		contractABI := testEventsABI(t)
+
+		tx := &data.TransactionOnNetwork{
+			Logs: &goTransaction.ApiLogs{
+				Events: []*goTransaction.Events{
+					{Identifier: "transfer", Topics: [][]byte{[]byte("transfer")}},
+				},
+			},
+		}
+
+		_, err := DecodeEvents(contractABI, tx)
+		require.Equal(t, ErrMissingTopic, err)
+	})
+}